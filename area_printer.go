@@ -2,8 +2,8 @@ package pterm
 
 import (
 	"strings"
-
-	"atomicgo.dev/cursor"
+	"sync"
+	"time"
 
 	"github.com/pterm/pterm/internal"
 )
@@ -11,17 +11,40 @@ import (
 // DefaultArea is the default area printer.
 var DefaultArea = AreaPrinter{}
 
+// activeAreaPrinters contains all running AreaPrinters, so that they can be stopped by StopAll.
+var activeAreaPrinters = atomicActiveAreaPrinters{
+	printers: []*AreaPrinter{},
+	lock:     &sync.Mutex{},
+}
+
+type atomicActiveAreaPrinters struct {
+	printers []*AreaPrinter
+	lock     *sync.Mutex
+}
+
 // AreaPrinter prints an area which can be updated easily.
 // use this printer for live output like charts, algorithm visualizations, simulations and even games.
+// Active AreaPrinters are redrawn, when the terminal is resized.
 type AreaPrinter struct {
 	RemoveWhenDone bool
 	Fullscreen     bool
 	Center         bool
+	// Header is printed once above the content and is only redrawn if the terminal width changes.
+	Header string
+	// MaxFPS limits how often the area is redrawn per second. Zero, or below, disables the limit.
+	MaxFPS int
 
 	content  string
 	isActive bool
 
-	area *cursor.Area
+	lock         *sync.Mutex
+	rendered     string
+	lastRender   time.Time
+	pendingFrame *time.Timer
+
+	area        *terminalArea
+	headerArea  *terminalArea
+	headerWidth int
 }
 
 // GetContent returns the current area content.
@@ -47,23 +70,92 @@ func (p AreaPrinter) WithCenter(b ...bool) *AreaPrinter {
 	return &p
 }
 
+// WithHeader sets a static header, which is printed once above the content of the AreaPrinter.
+// Updates only redraw the content below the header, unless the terminal width changes.
+func (p AreaPrinter) WithHeader(header string) *AreaPrinter {
+	p.Header = header
+	return &p
+}
+
+// WithMaxFPS limits how often the AreaPrinter is redrawn per second.
+// Updates in between are coalesced, so only the latest content is drawn in the next frame.
+// Stop always draws the latest content.
+func (p AreaPrinter) WithMaxFPS(n int) *AreaPrinter {
+	p.MaxFPS = n
+	return &p
+}
+
+// lazyInit initializes the lock, which serializes the redraws of the AreaPrinter.
+func (p *AreaPrinter) lazyInit() {
+	if p.lock == nil {
+		p.lock = &sync.Mutex{}
+	}
+}
+
 // Update overwrites the content of the AreaPrinter.
 // Can be used live.
+// Content, which equals the drawn content, is not drawn again.
 func (p *AreaPrinter) Update(text ...interface{}) {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.content = Sprint(text...)
+
+	if p.MaxFPS <= 0 || !p.isActive {
+		p.render()
+		return
+	}
+	if p.pendingFrame != nil {
+		// the next frame draws the latest content
+		return
+	}
+	frameDuration := time.Second / time.Duration(p.MaxFPS)
+	if wait := frameDuration - time.Since(p.lastRender); wait > 0 {
+		p.pendingFrame = time.AfterFunc(wait, func() {
+			p.lock.Lock()
+			defer p.lock.Unlock()
+			p.flush()
+		})
+		return
+	}
+	p.render()
+}
+
+// flush draws a pending frame immediately.
+// The caller has to hold the lock.
+func (p *AreaPrinter) flush() {
+	if p.pendingFrame == nil {
+		return
+	}
+	p.pendingFrame.Stop()
+	p.pendingFrame = nil
+	p.render()
+}
+
+// render draws the content, if it differs from the drawn content.
+// The caller has to hold the lock.
+func (p *AreaPrinter) render() {
 	if p.area == nil {
-		newArea := cursor.NewArea()
-		p.area = &newArea
+		p.area = &terminalArea{}
 	}
-	str := Sprint(text...)
-	p.content = str
+	str := p.content
 
 	if p.Center {
 		str = DefaultCenter.Sprint(str)
 	}
 
+	var headerHeight int
+	if p.Header != "" {
+		p.updateHeader()
+		headerHeight = strings.Count(p.wrappedHeader(), "\n") + 1
+		// wrap the content, so that the area knows its real height
+		str = wrapToWidth(str, p.headerWidth)
+	}
+
 	if p.Fullscreen {
 		str = strings.TrimRight(str, "\n")
-		height := GetTerminalHeight()
+		height := GetTerminalHeight() - headerHeight
 		contentHeight := strings.Count(str, "\n")
 
 		topPadding := 0
@@ -79,17 +171,64 @@ func (p *AreaPrinter) Update(text ...interface{}) {
 			str += strings.Repeat("\n", bottomPadding)
 		}
 	}
-	p.area.Update(str)
+	p.lastRender = time.Now()
+	if str == p.rendered {
+		return
+	}
+	p.rendered = str
+	p.area.update(str)
+}
+
+// updateHeader prints the header, if it wasn't printed yet, or if the terminal width changed since it was printed.
+func (p *AreaPrinter) updateHeader() {
+	width := GetTerminalWidth()
+	if p.headerArea != nil && width == p.headerWidth {
+		return
+	}
+	if p.headerArea != nil {
+		// the content is below the header, so it has to be cleared first
+		p.area.clear()
+		p.headerArea.clear()
+	}
+	p.headerArea = &terminalArea{}
+	p.headerWidth = width
+	p.headerArea.update(p.wrappedHeader())
+
+	p.area = &terminalArea{}
+	p.rendered = ""
+}
+
+// wrappedHeader returns the header wrapped to the terminal width.
+func (p *AreaPrinter) wrappedHeader() string {
+	return wrapToWidth(strings.TrimRight(p.Header, "\n"), p.headerWidth)
+}
+
+// wrapToWidth splits every line of s, which is wider than width, into multiple lines.
+func wrapToWidth(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(internal.SplitStringByWidth(line, width), "\n")
+	}
+	return strings.Join(lines, "\n")
 }
 
 // Start the AreaPrinter.
 func (p *AreaPrinter) Start(text ...interface{}) (*AreaPrinter, error) {
+	p.lazyInit()
+	p.lock.Lock()
+	if !p.isActive {
+		activeAreaPrinters.lock.Lock()
+		activeAreaPrinters.printers = append(activeAreaPrinters.printers, p)
+		activeAreaPrinters.lock.Unlock()
+	}
 	p.isActive = true
-	str := Sprint(text...)
-	newArea := cursor.NewArea()
-	p.area = &newArea
+	p.area = &terminalArea{}
+	p.headerArea = nil
+	p.rendered = ""
+	p.lastRender = time.Time{}
+	p.lock.Unlock()
 
-	p.Update(str)
+	p.Update(text...)
 
 	return p, nil
 }
@@ -97,12 +236,26 @@ func (p *AreaPrinter) Start(text ...interface{}) (*AreaPrinter, error) {
 // Stop terminates the AreaPrinter immediately.
 // The AreaPrinter will not resolve into anything.
 func (p *AreaPrinter) Stop() error {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	if !p.isActive {
 		return nil
 	}
 	p.isActive = false
+	activeAreaPrinters.lock.Lock()
+	active := activeAreaPrinters.printers[:0]
+	for _, area := range activeAreaPrinters.printers {
+		if area != p {
+			active = append(active, area)
+		}
+	}
+	activeAreaPrinters.printers = active
+	activeAreaPrinters.lock.Unlock()
+	p.flush()
 	if p.RemoveWhenDone {
-		p.Clear()
+		p.restore()
 	}
 	return nil
 }
@@ -125,9 +278,82 @@ func (p *AreaPrinter) GenericStop() (*LivePrinter, error) {
 	return &lp, nil
 }
 
-// Clear is a Wrapper function that clears the content of the Area
-// moves the cursor to the bottom of the terminal, clears n lines upwards from
-// the current position and moves the cursor again.
+// redrawAreaPrinters draws the content of all active AreaPrinters again.
+func redrawAreaPrinters() {
+	activeAreaPrinters.lock.Lock()
+	areas := append([]*AreaPrinter{}, activeAreaPrinters.printers...)
+	activeAreaPrinters.lock.Unlock()
+	for _, area := range areas {
+		area.redraw()
+	}
+}
+
+// redraw draws the content again, even if it equals the drawn content, so that the layout fits the terminal size.
+func (p *AreaPrinter) redraw() {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.isActive {
+		return
+	}
+	if p.pendingFrame != nil {
+		p.pendingFrame.Stop()
+		p.pendingFrame = nil
+	}
+	p.rendered = ""
+	p.render()
+}
+
+// renderedContent returns the content, as it was drawn the last time.
+func (p *AreaPrinter) renderedContent() string {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.rendered
+}
+
+// Clear clears the content of the AreaPrinter, but not the header, and moves the cursor to the start of the content.
+// Lines, which were wrapped by the terminal, are cleared as well, even if the terminal was resized in between.
 func (p *AreaPrinter) Clear() {
-	p.area.Clear()
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.clear()
+}
+
+// RestoreCursor clears the content and the header of the AreaPrinter and moves the cursor back to the position,
+// where the AreaPrinter was started. This can be used for transient output, like menus, which should not leave anything behind.
+// Lines, which were wrapped by the terminal, are cleared as well, even if the terminal was resized in between.
+func (p *AreaPrinter) RestoreCursor() {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.pendingFrame != nil {
+		p.pendingFrame.Stop()
+		p.pendingFrame = nil
+	}
+	p.restore()
+}
+
+// clear clears the content of the Area.
+// The caller has to hold the lock.
+func (p *AreaPrinter) clear() {
+	if p.area != nil {
+		p.area.clear()
+	}
+	p.rendered = ""
+}
+
+// restore clears the content and the header of the Area.
+// The caller has to hold the lock.
+func (p *AreaPrinter) restore() {
+	p.clear()
+	if p.headerArea != nil {
+		p.headerArea.clear()
+		// the header is printed again with the next update
+		p.headerArea = nil
+	}
 }
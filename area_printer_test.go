@@ -1,10 +1,15 @@
 package pterm_test
 
 import (
+	"io"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"atomicgo.dev/cursor"
 	"github.com/MarvinJWendt/testza"
+
 	"github.com/pterm/pterm"
 )
 
@@ -125,3 +130,186 @@ func TestAreaPrinter_Clear(t *testing.T) {
 
 	os.Stdout = originalStdout // Restore original os.Stdout
 }
+
+func TestAreaPrinter_WithHeader(t *testing.T) {
+	p := pterm.AreaPrinter{}
+	p2 := p.WithHeader("Dashboard")
+
+	testza.AssertEqual(t, "Dashboard", p2.Header)
+	testza.AssertZero(t, p.Header)
+}
+
+func TestAreaPrinter_HeaderIsPrintedOnce(t *testing.T) {
+	defer pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+
+	out, err := os.CreateTemp(t.TempDir(), "stdout")
+	testza.AssertNoError(t, err)
+	originalStdout := os.Stdout
+	os.Stdout = out
+
+	area, _ := pterm.DefaultArea.WithHeader("Dashboard").Start("frame 1")
+	area.Update("frame 2")
+	area.Update("frame 3")
+	area.Stop()
+
+	os.Stdout = originalStdout
+	content, _ := os.ReadFile(out.Name())
+	testza.AssertEqual(t, 1, strings.Count(string(content), "Dashboard"))
+	testza.AssertContains(t, string(content), "frame 3")
+	testza.AssertEqual(t, "frame 3", area.GetContent())
+}
+
+func TestAreaPrinter_HeaderIsRedrawnAfterResize(t *testing.T) {
+	defer pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+
+	out, err := os.CreateTemp(t.TempDir(), "stdout")
+	testza.AssertNoError(t, err)
+	originalStdout := os.Stdout
+	os.Stdout = out
+
+	area, _ := pterm.DefaultArea.WithHeader("Dashboard").Start("frame 1")
+	pterm.SetForcedTerminalSize(4, terminalHeight)
+	area.Update("frame 2")
+	area.Stop()
+
+	os.Stdout = originalStdout
+	content, _ := os.ReadFile(out.Name())
+	testza.AssertContains(t, string(content), "Dashboard")
+	// the header is wrapped to the new terminal width
+	testza.AssertContains(t, string(content), "Dash\nboar\nd")
+}
+
+func TestAreaPrinter_WithMaxFPS(t *testing.T) {
+	p := pterm.AreaPrinter{}
+	p2 := p.WithMaxFPS(30)
+
+	testza.AssertEqual(t, 30, p2.MaxFPS)
+	testza.AssertZero(t, p.MaxFPS)
+}
+
+// captureArea returns everything, which the AreaPrinter writes to os.Stdout while f runs.
+func captureArea(t *testing.T, f func()) string {
+	out, err := os.CreateTemp(t.TempDir(), "stdout")
+	testza.AssertNoError(t, err)
+	originalStdout := os.Stdout
+	os.Stdout = out
+
+	f()
+
+	os.Stdout = originalStdout
+	content, _ := os.ReadFile(out.Name())
+	return string(content)
+}
+
+func TestAreaPrinter_MaxFPSCoalescesUpdates(t *testing.T) {
+	content := captureArea(t, func() {
+		area, _ := pterm.DefaultArea.WithMaxFPS(5).Start("frame-0")
+		for i := 1; i <= 100; i++ {
+			area.Update(pterm.Sprintf("frame-%d", i))
+		}
+		area.Stop()
+	})
+
+	testza.AssertContains(t, content, "frame-0\n")
+	testza.AssertNotContains(t, content, "frame-50\n")
+	// the latest frame is drawn on Stop
+	testza.AssertContains(t, content, "frame-100\n")
+}
+
+func TestAreaPrinter_MaxFPSDrawsLatestFrame(t *testing.T) {
+	var beforeStop []byte
+	content := captureArea(t, func() {
+		area, _ := pterm.DefaultArea.WithMaxFPS(20).Start("frame-0")
+		area.Update("frame-1")
+		area.Update("frame-2")
+		time.Sleep(200 * time.Millisecond)
+		beforeStop, _ = os.ReadFile(os.Stdout.Name())
+		// Stop waits for a pending frame, so that os.Stdout can be restored safely
+		area.Stop()
+	})
+
+	testza.AssertContains(t, string(beforeStop), "frame-2\n")
+	testza.AssertNotContains(t, content, "frame-1\n")
+	testza.AssertEqual(t, 1, strings.Count(content, "frame-2\n"))
+}
+
+func TestAreaPrinter_UnchangedContentIsNotRedrawn(t *testing.T) {
+	content := captureArea(t, func() {
+		area, _ := pterm.DefaultArea.Start("same")
+		area.Update("same")
+		area.Update("same")
+		area.Update("other")
+		area.Update("same")
+		area.Stop()
+	})
+
+	testza.AssertEqual(t, 2, strings.Count(content, "same"))
+}
+
+func TestAreaPrinter_RestoreCursorClearsWrappedLines(t *testing.T) {
+	defer pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+	pterm.SetForcedTerminalSize(10, terminalHeight)
+
+	out, err := os.CreateTemp(t.TempDir(), "stdout")
+	testza.AssertNoError(t, err)
+	originalStdout := os.Stdout
+	os.Stdout = out
+	cursor.SetTarget(out)
+
+	// the first line is wrapped into three rows
+	area, _ := pterm.DefaultArea.Start("abcdefghijklmnopqrstuvwxy\nz")
+	cursorStart, _ := out.Seek(0, io.SeekCurrent)
+	area.RestoreCursor()
+	area.Stop()
+
+	cursor.SetTarget(originalStdout)
+	os.Stdout = originalStdout
+	content, _ := os.ReadFile(out.Name())
+	testza.AssertEqual(t, 4, strings.Count(string(content[cursorStart:]), "\x1b[1A\x1b[2K"))
+}
+
+func TestAreaPrinter_ClearAfterResize(t *testing.T) {
+	defer pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+	pterm.SetForcedTerminalSize(10, terminalHeight)
+
+	out, err := os.CreateTemp(t.TempDir(), "stdout")
+	testza.AssertNoError(t, err)
+	originalStdout := os.Stdout
+	os.Stdout = out
+	cursor.SetTarget(out)
+
+	area, _ := pterm.DefaultArea.Start("abcdefgh")
+	cursorStart, _ := out.Seek(0, io.SeekCurrent)
+	// the terminal reflows the line into two rows
+	pterm.SetForcedTerminalSize(4, terminalHeight)
+	area.Clear()
+	area.Stop()
+
+	cursor.SetTarget(originalStdout)
+	os.Stdout = originalStdout
+	content, _ := os.ReadFile(out.Name())
+	testza.AssertEqual(t, 2, strings.Count(string(content[cursorStart:]), "\x1b[1A\x1b[2K"))
+}
+
+func TestAreaPrinter_ClearAfterWidening(t *testing.T) {
+	defer pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+	pterm.SetForcedTerminalSize(4, terminalHeight)
+
+	out, err := os.CreateTemp(t.TempDir(), "stdout")
+	testza.AssertNoError(t, err)
+	originalStdout := os.Stdout
+	os.Stdout = out
+	cursor.SetTarget(out)
+
+	area, _ := pterm.DefaultArea.Start("abcdefgh")
+	cursorStart, _ := out.Seek(0, io.SeekCurrent)
+	// the terminal reflows the two rows into one row, so rows above the area must not be cleared
+	pterm.SetForcedTerminalSize(10, terminalHeight)
+	area.Clear()
+	area.Stop()
+
+	cursor.SetTarget(originalStdout)
+	os.Stdout = originalStdout
+	content, _ := os.ReadFile(out.Name())
+	testza.AssertEqual(t, 1, strings.Count(string(content[cursorStart:]), "\x1b[1A\x1b[2K"))
+}
@@ -248,6 +248,10 @@ func (p BarChartPrinter) Srender() (string, error) {
 	if RawOutput.Load() {
 		return p.getRawOutput(), nil
 	}
+
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+
 	for i, bar := range p.Bars {
 		if bar.Style == nil {
 			p.Bars[i].Style = &ThemeDefault.BarStyle
@@ -45,7 +45,13 @@ type BigTextPrinter struct {
 	// BigCharacters holds the map from a normal character to it's big version.
 	BigCharacters map[string]string
 	Letters       Letters
-	Writer        io.Writer
+	// LetterSpacing is the number of columns between two letters.
+	// If it is zero, the letters keep the spacing of their BigCharacters.
+	LetterSpacing int
+	// If Compact is true, the letters are printed without spacing between them.
+	// Texts, which are wider than the terminal, are printed compact as well.
+	Compact bool
+	Writer  io.Writer
 }
 
 // WithBigCharacters returns a new BigTextPrinter with specific BigCharacters.
@@ -64,6 +70,23 @@ func (p BigTextPrinter) WithLetters(letters ...Letters) *BigTextPrinter {
 	return &p
 }
 
+// WithLetterSpacing returns a new BigTextPrinter with a specific LetterSpacing.
+// Zero, or below, keeps the spacing of the BigCharacters.
+func (p BigTextPrinter) WithLetterSpacing(spacing int) *BigTextPrinter {
+	if spacing < 0 {
+		spacing = 0
+	}
+	p.LetterSpacing = spacing
+	return &p
+}
+
+// WithCompact returns a new BigTextPrinter, which prints the letters without spacing between them.
+// This makes wide texts fit into narrow terminals.
+func (p BigTextPrinter) WithCompact(b ...bool) *BigTextPrinter {
+	p.Compact = internal.WithBoolean(b)
+	return &p
+}
+
 // WithWriter sets the custom Writer.
 func (p BigTextPrinter) WithWriter(writer io.Writer) *BigTextPrinter {
 	p.Writer = writer
@@ -92,6 +115,10 @@ func (p BigTextPrinter) Srender() (string, error) {
 		}
 	}
 
+	if !p.Compact && p.textWidth(bigLetters) > GetTerminalWidth() {
+		p.Compact = true
+	}
+
 	var maxHeight int
 
 	for _, l := range bigLetters {
@@ -105,9 +132,9 @@ func (p BigTextPrinter) Srender() (string, error) {
 		for _, letter := range bigLetters {
 			var letterLine string
 			letterLines := strings.Split(letter.String, "\n")
-			maxLetterWidth := internal.GetStringMaxWidth(letter.String)
+			maxLetterWidth := p.letterWidth(letterLines)
 			if len(letterLines) > i {
-				letterLine = letterLines[i]
+				letterLine = strings.TrimRight(letterLines[i], " ")
 			}
 			letterLineLength := runewidth.StringWidth(letterLine)
 			if letterLineLength < maxLetterWidth {
@@ -126,6 +153,33 @@ func (p BigTextPrinter) Srender() (string, error) {
 	return ret, nil
 }
 
+// textWidth returns the width of the big letters.
+func (p BigTextPrinter) textWidth(bigLetters Letters) int {
+	var width int
+	for _, letter := range bigLetters {
+		width += p.letterWidth(strings.Split(letter.String, "\n"))
+	}
+	return width
+}
+
+// letterWidth returns the width of a big letter, including the spacing after it.
+// Letters, which only contain whitespace, keep their width and get no additional spacing.
+func (p BigTextPrinter) letterWidth(letterLines []string) int {
+	var width int
+	for _, line := range letterLines {
+		if w := runewidth.StringWidth(strings.TrimRight(line, " ")); w > width {
+			width = w
+		}
+	}
+	switch {
+	case width == 0 || (!p.Compact && p.LetterSpacing == 0):
+		return internal.GetStringMaxWidth(strings.Join(letterLines, "\n"))
+	case p.Compact:
+		return width
+	}
+	return width + p.LetterSpacing
+}
+
 // Render prints the BigText to the terminal.
 func (p BigTextPrinter) Render() error {
 	s, _ := p.Srender()
@@ -164,3 +164,59 @@ func TestBigTextPrinter_WithWriter(t *testing.T) {
 	testza.AssertEqual(t, s, p2.Writer)
 	testza.AssertZero(t, p.Writer)
 }
+
+func TestBigTextPrinter_WithLetterSpacing(t *testing.T) {
+	p := pterm.BigTextPrinter{}
+	testza.AssertEqual(t, 3, p.WithLetterSpacing(3).LetterSpacing)
+	testza.AssertEqual(t, 0, p.WithLetterSpacing(-1).LetterSpacing)
+}
+
+func TestBigTextPrinter_WithCompact(t *testing.T) {
+	p := pterm.DefaultBigText.WithCompact()
+	testza.AssertTrue(t, p.Compact)
+	testza.AssertFalse(t, pterm.DefaultBigText.Compact)
+}
+
+func TestBigTextPrinter_SrenderLetterSpacing(t *testing.T) {
+	p := pterm.BigTextPrinter{
+		BigCharacters: map[string]string{"a": "aa \naa ", "b": "b  \nbb ", " ": "  \n  "},
+		Letters:       pterm.NewLettersFromString("ab a"),
+	}
+	tests := []struct {
+		spacing  int
+		expected string
+	}{
+		{spacing: 0, expected: "aa b    aa \naa bb   aa \n"},
+		{spacing: 1, expected: "aa b    aa \naa bb   aa \n"},
+		{spacing: 2, expected: "aa  b     aa  \naa  bb    aa  \n"},
+	}
+	for _, tt := range tests {
+		content, err := p.WithLetterSpacing(tt.spacing).Srender()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, tt.expected, pterm.RemoveColorFromString(content))
+	}
+
+	content, err := p.WithLetterSpacing(2).WithCompact().Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "aab   aa\naabb  aa\n", pterm.RemoveColorFromString(content))
+}
+
+func TestBigTextPrinter_SrenderKeepsSpacingOfBigCharacters(t *testing.T) {
+	p := pterm.BigTextPrinter{
+		BigCharacters: map[string]string{"a": "aa  \naa", "b": "b\nbb "},
+		Letters:       pterm.NewLettersFromString("ab"),
+	}
+	content, err := p.Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "aa  b  \naa  bb \n", pterm.RemoveColorFromString(content))
+}
+
+func TestBigTextPrinter_SrenderWiderThanTerminal(t *testing.T) {
+	p := pterm.BigTextPrinter{
+		BigCharacters: map[string]string{"a": "aa  "},
+		Letters:       pterm.NewLettersFromString(strings.Repeat("a", 30)),
+	}
+	content, err := p.Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, strings.Repeat("aa", 30)+"\n", pterm.RemoveColorFromString(content))
+}
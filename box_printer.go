@@ -20,6 +20,7 @@ type BoxPrinter struct {
 	TitleBottomRight        bool
 	TitleBottomCenter       bool
 	TextStyle               *Style
+	BackgroundColor         *RGB
 	VerticalString          string
 	BoxStyle                *Style
 	HorizontalString        string
@@ -31,6 +32,7 @@ type BoxPrinter struct {
 	BottomPadding           int
 	RightPadding            int
 	LeftPadding             int
+	MaxWidth                int
 	Writer                  io.Writer
 }
 
@@ -52,6 +54,7 @@ var DefaultBox = BoxPrinter{
 }
 
 // WithTitle returns a new box with a specific Title.
+// The box is widened to fit the title, up to the MaxWidth or the terminal width. Wider titles are truncated with "…".
 func (p BoxPrinter) WithTitle(str string) *BoxPrinter {
 	p.Title = str
 	return &p
@@ -141,6 +144,13 @@ func (p BoxPrinter) WithTextStyle(style *Style) *BoxPrinter {
 	return &p
 }
 
+// WithBackgroundColor returns a new box with a specific background color inside the box.
+// Black or white is used as text color, whichever is more readable on the background.
+func (p BoxPrinter) WithBackgroundColor(rgb RGB) *BoxPrinter {
+	p.BackgroundColor = &rgb
+	return &p
+}
+
 // WithTopRightCornerString returns a new box with a specific TopRightCornerString.
 func (p BoxPrinter) WithTopRightCornerString(str string) *BoxPrinter {
 	p.TopRightCornerString = str
@@ -213,6 +223,14 @@ func (p BoxPrinter) WithLeftPadding(padding int) *BoxPrinter {
 	return &p
 }
 
+// WithMaxWidth returns a new box, which is at most maxWidth wide, including the borders and the padding.
+// Lines, which don't fit into the box, are wrapped at spaces. Words, which are wider than the box, are broken into multiple lines.
+// If maxWidth is zero, or below, the box is as wide as its content.
+func (p BoxPrinter) WithMaxWidth(maxWidth int) *BoxPrinter {
+	p.MaxWidth = maxWidth
+	return &p
+}
+
 // WithWriter sets the custom Writer.
 func (p BoxPrinter) WithWriter(writer io.Writer) *BoxPrinter {
 	p.Writer = writer
@@ -228,21 +246,44 @@ func (p BoxPrinter) Sprint(a ...interface{}) string {
 	if p.TextStyle == nil {
 		p.TextStyle = &ThemeDefault.BoxTextStyle
 	}
-	maxWidth := internal.GetStringMaxWidth(Sprint(a...))
+
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+
+	text := p.wrapText(Sprint(a...))
+	maxWidth := internal.GetStringMaxWidth(text)
+	if maxWidth+p.LeftPadding+p.RightPadding == 0 {
+		// an empty box still needs an inside
+		maxWidth = 1
+	}
 
 	var topLine string
 	var bottomLine string
 
+	// The title is surrounded by "─ " and " ─", so the interior has to be 4 cells wider than the title.
+	p.Title = strings.ReplaceAll(p.Title, "\n", " ")
+	if p.Title != "" {
+		interior := maxWidth + p.LeftPadding + p.RightPadding
+		limit := p.MaxWidth
+		if limit <= 0 {
+			limit = GetTerminalWidth()
+		}
+		limit -= 2 * runewidth.StringWidth(p.VerticalString)
+		if needed := internal.DisplayWidth(p.Title) + 4; needed > interior && limit > interior {
+			if needed > limit {
+				needed = limit
+			}
+			p.RightPadding += needed - interior
+		}
+		p.Title = internal.TruncateString(p.Title, maxWidth+p.LeftPadding+p.RightPadding-4, "…")
+	}
+
 	if p.Title == "" {
 		topLine = p.BoxStyle.Sprint(p.BottomRightCornerString) + strings.Repeat(p.BoxStyle.Sprint(p.HorizontalString),
 			maxWidth+p.LeftPadding+p.RightPadding) + p.BoxStyle.Sprint(p.BottomLeftCornerString)
 		bottomLine = p.BoxStyle.Sprint(p.TopRightCornerString) + strings.Repeat(p.BoxStyle.Sprint(p.HorizontalString),
 			maxWidth+p.LeftPadding+p.RightPadding) + p.BoxStyle.Sprint(p.TopLeftCornerString)
 	} else {
-		p.Title = strings.ReplaceAll(p.Title, "\n", " ")
-		if (maxWidth + p.RightPadding + p.LeftPadding - 4) < len(RemoveColorFromString(p.Title)) {
-			p.RightPadding = len(RemoveColorFromString(p.Title)) - (maxWidth + p.RightPadding + p.LeftPadding - 5)
-		}
 		if p.TitleTopLeft {
 			topLine = p.BoxStyle.Sprint(p.BottomRightCornerString) + internal.AddTitleToLine(p.Title, p.BoxStyle.Sprint(p.HorizontalString), maxWidth+p.LeftPadding+p.RightPadding, true) + p.BoxStyle.Sprint(p.BottomLeftCornerString)
 			bottomLine = p.BoxStyle.Sprint(p.TopRightCornerString) + strings.Repeat(p.BoxStyle.Sprint(p.HorizontalString),
@@ -270,22 +311,44 @@ func (p BoxPrinter) Sprint(a ...interface{}) string {
 		}
 	}
 
-	boxString := strings.Repeat("\n", p.TopPadding) + Sprint(a...) + strings.Repeat("\n", p.BottomPadding)
+	boxString := strings.Repeat("\n", p.TopPadding) + text + strings.Repeat("\n", p.BottomPadding)
 
 	ss := strings.Split(boxString, "\n")
 	for i, s2 := range ss {
+		var content string
 		if runewidth.StringWidth(RemoveColorFromString(s2)) < maxWidth {
-			ss[i] = p.BoxStyle.Sprint(p.VerticalString) + strings.Repeat(" ", p.LeftPadding) + p.TextStyle.Sprint(s2) +
-				strings.Repeat(" ", maxWidth-runewidth.StringWidth(RemoveColorFromString(s2))+p.RightPadding) +
-				p.BoxStyle.Sprint(p.VerticalString)
+			content = strings.Repeat(" ", p.LeftPadding) + p.TextStyle.Sprint(s2) +
+				strings.Repeat(" ", maxWidth-runewidth.StringWidth(RemoveColorFromString(s2))+p.RightPadding)
 		} else {
-			ss[i] = p.BoxStyle.Sprint(p.VerticalString) + strings.Repeat(" ", p.LeftPadding) + p.TextStyle.Sprint(s2) +
-				strings.Repeat(" ", p.RightPadding) + p.BoxStyle.Sprint(p.VerticalString)
+			content = strings.Repeat(" ", p.LeftPadding) + p.TextStyle.Sprint(s2) + strings.Repeat(" ", p.RightPadding)
 		}
+		if p.BackgroundColor != nil {
+			content = p.BackgroundColor.sprintOnBackground(content)
+		}
+		ss[i] = p.BoxStyle.Sprint(p.VerticalString) + content + p.BoxStyle.Sprint(p.VerticalString)
 	}
 	return topLine + "\n" + strings.Join(ss, "\n") + "\n" + bottomLine
 }
 
+// wrapText wraps the lines of text, which are wider than the space inside the box.
+func (p BoxPrinter) wrapText(text string) string {
+	if p.MaxWidth <= 0 {
+		return text
+	}
+	width := p.MaxWidth - p.LeftPadding - p.RightPadding - 2*runewidth.StringWidth(p.VerticalString)
+	if width < 1 {
+		width = 1
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if internal.DisplayWidth(line) > width {
+			lines[i] = internal.WrapWords(line, width)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // Sprintln formats using the default formats for its operands and returns the resulting string.
 // Spaces are always added between operands and a newline is appended.
 func (p BoxPrinter) Sprintln(a ...interface{}) string {
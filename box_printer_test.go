@@ -4,9 +4,11 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/MarvinJWendt/testza"
+	"github.com/mattn/go-runewidth"
 
 	"github.com/pterm/pterm"
 )
@@ -264,6 +266,18 @@ func TestBoxPrinter_WithTextStyle(t *testing.T) {
 	testza.AssertZero(t, p.TextStyle)
 }
 
+func TestBoxPrinter_WithBackgroundColor(t *testing.T) {
+	p := pterm.BoxPrinter{}
+	p2 := p.WithBackgroundColor(pterm.NewRGB(255, 255, 0))
+
+	testza.AssertEqual(t, pterm.NewRGB(255, 255, 0), *p2.BackgroundColor)
+	testza.AssertNil(t, p.BackgroundColor)
+
+	s := p2.Sprint("Hello")
+	testza.AssertContains(t, s, "38;2;0;0;0;48;2;255;255;0")
+	testza.AssertEqual(t, pterm.RemoveColorFromString(p.Sprint("Hello")), pterm.RemoveColorFromString(s))
+}
+
 func TestBoxPrinter_WithTopLeftCornerString(t *testing.T) {
 	p := pterm.BoxPrinter{}
 	p2 := p.WithTopLeftCornerString("-")
@@ -344,3 +358,107 @@ func TestBoxPrinter_WithWriter(t *testing.T) {
 	testza.AssertEqual(t, s, p2.Writer)
 	testza.AssertZero(t, p.Writer)
 }
+
+func TestBoxPrinter_WithTitleTruncated(t *testing.T) {
+	p := pterm.DefaultBox.WithTitle("This title is way too long for the box").WithMaxWidth(20)
+	lines := strings.Split(pterm.RemoveColorFromString(p.Sprint("Lorem Ipsum")), "\n")
+
+	testza.AssertContains(t, lines[0], "…")
+	for _, line := range lines {
+		testza.AssertEqual(t, 20, runewidth.StringWidth(line))
+	}
+}
+
+func TestBoxPrinter_WithTitleTruncatedAtTerminalWidth(t *testing.T) {
+	p := pterm.DefaultBox.WithTitle(strings.Repeat("a", 100))
+	lines := strings.Split(pterm.RemoveColorFromString(p.Sprint("Lorem Ipsum")), "\n")
+
+	testza.AssertContains(t, lines[0], "…")
+	for _, line := range lines {
+		testza.AssertEqual(t, pterm.GetTerminalWidth(), runewidth.StringWidth(line))
+	}
+}
+
+func TestBoxPrinter_WithTitleWidensShortContent(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	testza.AssertEqual(t, "┌─ Title ─┐\n| hi      |\n└─────────┘", pterm.DefaultBox.WithTitle("Title").Sprint("hi"))
+}
+
+func TestBoxPrinter_WithTitleNotTruncated(t *testing.T) {
+	p := pterm.DefaultBox.WithTitle("Title")
+	s := pterm.RemoveColorFromString(p.Sprint("Lorem Ipsum"))
+
+	testza.AssertContains(t, s, " Title ")
+	testza.AssertNotContains(t, s, "…")
+}
+
+func TestBoxPrinter_PaddingKeepsBorderAligned(t *testing.T) {
+	p := pterm.DefaultBox.WithTopPadding(1).WithBottomPadding(2).WithLeftPadding(3).WithRightPadding(4)
+	lines := strings.Split(pterm.RemoveColorFromString(p.Sprint("Lorem\nIpsum dolor")), "\n")
+
+	testza.AssertLen(t, lines, 7)
+	for _, line := range lines {
+		testza.AssertEqual(t, 3+len("Ipsum dolor")+4+2, runewidth.StringWidth(line))
+	}
+}
+
+func TestBoxPrinter_WithMaxWidth(t *testing.T) {
+	p := pterm.BoxPrinter{}
+	p2 := p.WithMaxWidth(20)
+
+	testza.AssertEqual(t, 20, p2.MaxWidth)
+	testza.AssertZero(t, p.MaxWidth)
+}
+
+func TestBoxPrinter_MaxWidthWrapsLongLines(t *testing.T) {
+	p := pterm.DefaultBox.WithMaxWidth(16)
+	lines := strings.Split(pterm.RemoveColorFromString(p.Sprint("short\nthis line is too long for the box")), "\n")
+
+	testza.AssertEqual(t, []string{
+		"┌──────────────┐",
+		"| short        |",
+		"| this line is |",
+		"| too long for |",
+		"| the box      |",
+		"└──────────────┘",
+	}, lines)
+}
+
+func TestBoxPrinter_MaxWidthBreaksLongWords(t *testing.T) {
+	p := pterm.DefaultBox.WithMaxWidth(10)
+	lines := strings.Split(pterm.RemoveColorFromString(p.Sprint("a https://example.com/path")), "\n")
+
+	testza.AssertEqual(t, []string{
+		"┌────────┐",
+		"| a      |",
+		"| https: |",
+		"| //exam |",
+		"| ple.co |",
+		"| m/path |",
+		"└────────┘",
+	}, lines)
+}
+
+func TestBoxPrinter_MaxWidthFitsContent(t *testing.T) {
+	s := pterm.RemoveColorFromString(pterm.DefaultBox.WithMaxWidth(80).Sprint("Hello"))
+	testza.AssertEqual(t, "┌───────┐\n| Hello |\n└───────┘", s)
+}
+
+func TestBoxPrinter_MaxWidthWideCharacters(t *testing.T) {
+	lines := strings.Split(pterm.RemoveColorFromString(pterm.DefaultBox.WithMaxWidth(14).Sprint("你好 世界 你好")), "\n")
+
+	for _, line := range lines {
+		testza.AssertEqual(t, 13, runewidth.StringWidth(line), line)
+	}
+	testza.AssertEqual(t, "| 你好 世界 |", lines[1])
+	testza.AssertEqual(t, "| 你好      |", lines[2])
+}
+
+func TestBoxPrinter_EmptyContent(t *testing.T) {
+	testza.AssertEqual(t, "┌──┐\n|  |\n└──┘", pterm.RemoveColorFromString(pterm.DefaultBox.Sprint("")))
+
+	p := pterm.DefaultBox.WithLeftPadding(0).WithRightPadding(0)
+	testza.AssertEqual(t, "┌─┐\n| |\n└─┘", pterm.RemoveColorFromString(p.Sprint("")))
+}
@@ -100,6 +100,9 @@ func (l BulletListPrinter) Render() error {
 
 // Srender renders the list as a string.
 func (l BulletListPrinter) Srender() (string, error) {
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+
 	var ret string
 	for _, item := range l.Items {
 		if item.TextStyle == nil {
@@ -2,9 +2,12 @@ package pterm
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/gookit/color"
+
+	"github.com/pterm/pterm/internal"
 )
 
 // PrintColor is false if PTerm should not print colored output.
@@ -137,9 +140,60 @@ var (
 	LightMagenta = FgLightMagenta.Sprint
 )
 
+// colorNames contains the names of all colors, which are used to encode colors as text.
+// Aliases like FgGray are only used for decoding.
+var colorNames = map[Color]string{
+	FgBlack: "FgBlack", FgRed: "FgRed", FgGreen: "FgGreen", FgYellow: "FgYellow", FgBlue: "FgBlue",
+	FgMagenta: "FgMagenta", FgCyan: "FgCyan", FgWhite: "FgWhite", FgDefault: "FgDefault",
+	FgDarkGray: "FgDarkGray", FgLightRed: "FgLightRed", FgLightGreen: "FgLightGreen", FgLightYellow: "FgLightYellow",
+	FgLightBlue: "FgLightBlue", FgLightMagenta: "FgLightMagenta", FgLightCyan: "FgLightCyan", FgLightWhite: "FgLightWhite",
+	BgBlack: "BgBlack", BgRed: "BgRed", BgGreen: "BgGreen", BgYellow: "BgYellow", BgBlue: "BgBlue",
+	BgMagenta: "BgMagenta", BgCyan: "BgCyan", BgWhite: "BgWhite", BgDefault: "BgDefault",
+	BgDarkGray: "BgDarkGray", BgLightRed: "BgLightRed", BgLightGreen: "BgLightGreen", BgLightYellow: "BgLightYellow",
+	BgLightBlue: "BgLightBlue", BgLightMagenta: "BgLightMagenta", BgLightCyan: "BgLightCyan", BgLightWhite: "BgLightWhite",
+	Reset: "Reset", Bold: "Bold", Fuzzy: "Fuzzy", Italic: "Italic", Underscore: "Underscore", Blink: "Blink",
+	FastBlink: "FastBlink", Reverse: "Reverse", Concealed: "Concealed", Strikethrough: "Strikethrough",
+}
+
+// colorAliases contains alternative names of colors.
+var colorAliases = map[string]Color{
+	"FgGray": FgGray,
+	"BgGray": BgGray,
+}
+
 // Color is a number which will be used to color strings in the terminal.
 type Color uint8
 
+// MarshalText encodes the color as its name, for example "FgRed".
+// Colors without a name are encoded as their number.
+func (c Color) MarshalText() ([]byte, error) {
+	if name, ok := colorNames[c]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(c))), nil
+}
+
+// UnmarshalText decodes a color from its name, for example "FgRed", or its number.
+// An unknown name returns ErrUnknownColor.
+func (c *Color) UnmarshalText(text []byte) error {
+	name := string(text)
+	if color, ok := colorAliases[name]; ok {
+		*c = color
+		return nil
+	}
+	for color, colorName := range colorNames {
+		if colorName == name {
+			*c = color
+			return nil
+		}
+	}
+	if number, err := strconv.ParseUint(name, 10, 8); err == nil {
+		*c = Color(number)
+		return nil
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownColor, name)
+}
+
 // Sprintln formats using the default formats for its operands and returns the resulting string.
 // Spaces are always added between operands and a newline is appended.
 // Input will be colored with the parent Color.
@@ -271,6 +325,16 @@ func NewStyle(colors ...Color) *Style {
 	return &ret
 }
 
+// NewRGBStyle returns a new Style with an RGB foreground and an optional RGB background.
+// Further colors, like Bold, can be added with Style.Add.
+func NewRGBStyle(foreground RGB, background ...RGB) *Style {
+	ret := *foreground.ToStyle()
+	if len(background) > 0 {
+		ret = ret.Add(*background[0].ToBackgroundStyle())
+	}
+	return &ret
+}
+
 // Add styles to the current Style.
 func (s Style) Add(styles ...Style) Style {
 	ret := s
@@ -317,6 +381,22 @@ func (s Style) Sprintfln(format string, a ...interface{}) string {
 	return s.Sprint(Sprintf(format, a...) + "\n")
 }
 
+// SprintPadded colors the text with the Style and pads it with spaces to the given display width.
+// The spaces are not colored, so that aligned columns line up. Text, which is wider than the width, is kept as it is.
+func (s Style) SprintPadded(width int, text string) string {
+	styled := s.Sprint(text)
+	if w := internal.DisplayWidth(styled); w < width {
+		styled += strings.Repeat(" ", width-w)
+	}
+	return styled
+}
+
+// SprintTruncated colors the text with the Style, so that it takes up exactly the given display width.
+// Wider text is truncated with "…" and narrower text is padded with spaces, which are not colored.
+func (s Style) SprintTruncated(width int, text string) string {
+	return s.SprintPadded(width, internal.TruncateString(text, width, "…"))
+}
+
 // Print formats using the default formats for its operands and writes to standard output.
 // Spaces are added between operands when neither is a string.
 // It returns the number of bytes written and any write error encountered.
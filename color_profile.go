@@ -0,0 +1,121 @@
+package pterm
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gookit/color"
+	"go.uber.org/atomic"
+	"golang.org/x/term"
+)
+
+// ColorLevel describes how many colors a terminal can display.
+type ColorLevel int
+
+const (
+	// NoColor means that the terminal can't display colors.
+	NoColor ColorLevel = iota
+	// ANSI16 means that the terminal can display the 16 basic ANSI colors.
+	ANSI16
+	// ANSI256 means that the terminal can display 256 colors.
+	ANSI256
+	// TrueColor means that the terminal can display 24-bit RGB colors.
+	TrueColor
+)
+
+// DownsampleRGB is true if RGB colors should be converted to the nearest color the terminal supports.
+// Use pterm.EnableRGBDownsampling() or pterm.DisableRGBDownsampling() to change this variable.
+var DownsampleRGB = atomic.NewBool(false)
+
+// EnableRGBDownsampling converts RGB colors to the nearest 256 or 16 color, if the terminal doesn't support TrueColor.
+func EnableRGBDownsampling() {
+	DownsampleRGB.Store(true)
+}
+
+// DisableRGBDownsampling always prints RGB colors as TrueColor. This is the default.
+func DisableRGBDownsampling() {
+	DownsampleRGB.Store(false)
+}
+
+// SupportsTrueColor returns true if the terminal can display 24-bit RGB colors.
+func SupportsTrueColor() bool {
+	return ColorProfile() == TrueColor
+}
+
+// IsTerminal returns true if stdout is a terminal, or if the terminal detection was overridden
+// with pterm.SetForceTTY or the PTERM_FORCE_COLOR environment variable.
+func IsTerminal() bool {
+	return forcedTTY() || term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// forcedTTY returns true, if the terminal detection was overridden with pterm.SetForceTTY or the PTERM_FORCE_COLOR environment variable.
+func forcedTTY() bool {
+	if ForceTTY.Load() {
+		return true
+	}
+	force, err := strconv.ParseBool(os.Getenv("PTERM_FORCE_COLOR"))
+	return err == nil && force
+}
+
+// enableForcedColor enables the colors, because the output is treated as a terminal,
+// unless they were disabled explicitly with DisableColor or the NO_COLOR environment variable.
+func enableForcedColor() {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return
+	}
+	pLock.Lock()
+	defer pLock.Unlock()
+	if !PrintColor {
+		return
+	}
+	color.Enable = true
+	color.ForceColor()
+}
+
+// ColorProfile returns the color level of the terminal.
+// It is detected from the environment variables NO_COLOR, FORCE_COLOR, COLORTERM and TERM, and if stdout is a terminal (see IsTerminal).
+// FORCE_COLOR can be set to "0", "1", "2" or "3" to force NoColor, ANSI16, ANSI256 or TrueColor.
+func ColorProfile() ColorLevel {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return NoColor
+	}
+
+	force, forced := os.LookupEnv("FORCE_COLOR")
+	switch force {
+	case "0":
+		return NoColor
+	case "1":
+		return ANSI16
+	case "2":
+		return ANSI256
+	case "3":
+		return TrueColor
+	}
+	if !forced && !IsTerminal() {
+		return NoColor
+	}
+
+	return colorLevelFromEnv(os.Getenv("TERM"), os.Getenv("COLORTERM"))
+}
+
+// colorLevelFromEnv returns the color level, which is described by the TERM and COLORTERM environment variables.
+func colorLevelFromEnv(termName, colorTerm string) ColorLevel {
+	switch strings.ToLower(colorTerm) {
+	case "truecolor", "24bit":
+		return TrueColor
+	}
+	if termName == "dumb" {
+		return NoColor
+	}
+	switch {
+	case strings.Contains(termName, "truecolor"), strings.Contains(termName, "24bit"), strings.HasSuffix(termName, "-direct"):
+		return TrueColor
+	case strings.Contains(termName, "256color"):
+		return ANSI256
+	case termName == "" && os.Getenv("WT_SESSION") != "":
+		// Windows Terminal doesn't set TERM, but supports TrueColor.
+		return TrueColor
+	}
+	return ANSI16
+}
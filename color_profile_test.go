@@ -0,0 +1,183 @@
+package pterm_test
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/gookit/color"
+	"github.com/pterm/pterm"
+)
+
+// setColorEnv sets the color related environment variables for the duration of the test.
+// Empty values unset the variable.
+func setColorEnv(t *testing.T, noColor, forceColor, colorTerm, term string) {
+	for key, value := range map[string]string{"NO_COLOR": noColor, "FORCE_COLOR": forceColor, "COLORTERM": colorTerm, "TERM": term} {
+		t.Setenv(key, value)
+		if value == "" {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+func TestColorProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		noColor    string
+		forceColor string
+		colorTerm  string
+		term       string
+		expected   pterm.ColorLevel
+	}{
+		{name: "NoColor", noColor: "1", forceColor: "3", expected: pterm.NoColor},
+		{name: "ForceNoColor", forceColor: "0", colorTerm: "truecolor", expected: pterm.NoColor},
+		{name: "Force16", forceColor: "1", colorTerm: "truecolor", expected: pterm.ANSI16},
+		{name: "Force256", forceColor: "2", expected: pterm.ANSI256},
+		{name: "ForceTrueColor", forceColor: "3", term: "dumb", expected: pterm.TrueColor},
+		{name: "ColorTerm", forceColor: "true", colorTerm: "truecolor", term: "xterm", expected: pterm.TrueColor},
+		{name: "Term256", forceColor: "true", term: "xterm-256color", expected: pterm.ANSI256},
+		{name: "TermDirect", forceColor: "true", term: "xterm-direct", expected: pterm.TrueColor},
+		{name: "TermDumb", forceColor: "true", term: "dumb", expected: pterm.NoColor},
+		{name: "Term16", forceColor: "true", term: "xterm", expected: pterm.ANSI16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setColorEnv(t, tt.noColor, tt.forceColor, tt.colorTerm, tt.term)
+			testza.AssertEqual(t, tt.expected, pterm.ColorProfile())
+			testza.AssertEqual(t, tt.expected == pterm.TrueColor, pterm.SupportsTrueColor())
+		})
+	}
+}
+
+func TestColorProfile_NoTerminal(t *testing.T) {
+	setColorEnv(t, "", "", "truecolor", "xterm-256color")
+	// stdout is not a terminal while testing
+	testza.AssertEqual(t, pterm.NoColor, pterm.ColorProfile())
+}
+
+func TestColorProfile_ForceTTY(t *testing.T) {
+	setColorEnv(t, "", "", "", "xterm-256color")
+	pterm.SetForceTTY(true)
+	defer pterm.SetForceTTY(false)
+
+	testza.AssertTrue(t, pterm.IsTerminal())
+	testza.AssertEqual(t, pterm.ANSI256, pterm.ColorProfile())
+}
+
+func TestColorProfile_ForceTTYEnv(t *testing.T) {
+	setColorEnv(t, "", "", "", "xterm-256color")
+	t.Setenv("PTERM_FORCE_COLOR", "1")
+
+	testza.AssertTrue(t, pterm.IsTerminal())
+	testza.AssertEqual(t, pterm.ANSI256, pterm.ColorProfile())
+
+	t.Setenv("PTERM_FORCE_COLOR", "false")
+	testza.AssertFalse(t, pterm.IsTerminal())
+	testza.AssertEqual(t, pterm.NoColor, pterm.ColorProfile())
+}
+
+func TestColorProfile_ForceTTYNoColorWins(t *testing.T) {
+	setColorEnv(t, "1", "", "", "xterm-256color")
+	pterm.SetForceTTY(true)
+	defer pterm.SetForceTTY(false)
+
+	testza.AssertEqual(t, pterm.NoColor, pterm.ColorProfile())
+}
+
+func TestSetForceTTY_EnablesColor(t *testing.T) {
+	setColorEnv(t, "", "", "", "")
+	// colors were not enabled by the terminal detection
+	color.Enable = false
+	defer pterm.EnableColor()
+	pterm.SetForceTTY(true)
+	defer pterm.SetForceTTY(false)
+
+	testza.AssertEqual(t, "\x1b[31mHello\x1b[0m", pterm.FgRed.Sprint("Hello"))
+}
+
+func TestSetForceTTY_DisableColorWins(t *testing.T) {
+	setColorEnv(t, "", "", "", "")
+	pterm.DisableColor()
+	defer pterm.EnableColor()
+	pterm.SetForceTTY(true)
+	defer pterm.SetForceTTY(false)
+
+	testza.AssertEqual(t, "Hello", pterm.FgRed.Sprint("Hello"))
+}
+
+func TestSetForceTTY_Pipe(t *testing.T) {
+	for _, forced := range []bool{false, true} {
+		stdoutReader, stdoutWriter, err := os.Pipe()
+		testza.AssertNoError(t, err)
+		stderrReader, stderrWriter, err := os.Pipe()
+		testza.AssertNoError(t, err)
+		if forced {
+			t.Setenv("PTERM_FORCE_COLOR", "true")
+		}
+		pterm.SetDefaultOutput(stdoutWriter)
+		pterm.SetErrorWriter(stderrWriter)
+
+		bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithShowElapsedTime(false).Start("Bar")
+		pterm.Error.Println("error")
+		bar.Stop()
+
+		setupStdoutCapture()
+		stdoutWriter.Close()
+		stderrWriter.Close()
+		stdout, _ := io.ReadAll(stdoutReader)
+		stderr, _ := io.ReadAll(stderrReader)
+
+		// the progressbar is redrawn below the error message only, if the pipes are treated as a terminal
+		testza.AssertTrue(t, strings.Contains(string(stdout), "\x1b["))
+		testza.AssertEqual(t, forced, strings.HasPrefix(string(stderr), "\r"+strings.Repeat(" ", pterm.GetTerminalWidth())), string(stderr))
+	}
+}
+
+func TestRGB_SprintDownsampling(t *testing.T) {
+	rgb := pterm.NewRGB(255, 0, 0)
+	trueColor := rgb.Sprint("Hello")
+
+	pterm.EnableRGBDownsampling()
+	defer pterm.DisableRGBDownsampling()
+
+	setColorEnv(t, "", "3", "", "")
+	testza.AssertEqual(t, trueColor, rgb.Sprint("Hello"))
+
+	t.Setenv("FORCE_COLOR", "2")
+	testza.AssertEqual(t, "\x1b[38;5;196mHello\x1b[0m", rgb.Sprint("Hello"))
+
+	t.Setenv("FORCE_COLOR", "1")
+	testza.AssertEqual(t, "\x1b[91mHello\x1b[0m", rgb.Sprint("Hello"))
+
+	t.Setenv("FORCE_COLOR", "0")
+	testza.AssertEqual(t, "Hello", rgb.Sprint("Hello"))
+}
+
+func TestRGB_SprintWithoutDownsampling(t *testing.T) {
+	setColorEnv(t, "", "1", "", "")
+	testza.AssertContains(t, pterm.NewRGB(255, 0, 0).Sprint("Hello"), "38;2;255;0;0")
+}
+
+func TestRGB_ToStyleDownsampling(t *testing.T) {
+	rgb := pterm.NewRGB(255, 0, 0)
+
+	pterm.EnableRGBDownsampling()
+	defer pterm.DisableRGBDownsampling()
+
+	setColorEnv(t, "", "3", "", "")
+	testza.AssertEqual(t, "38;2;255;0;0", rgb.ToStyle().String())
+	testza.AssertEqual(t, "48;2;255;0;0", rgb.ToBackgroundStyle().String())
+
+	t.Setenv("FORCE_COLOR", "2")
+	testza.AssertEqual(t, "38;5;196", rgb.ToStyle().String())
+	testza.AssertEqual(t, "48;5;196", rgb.ToBackgroundStyle().String())
+
+	t.Setenv("FORCE_COLOR", "1")
+	testza.AssertEqual(t, "91", rgb.ToStyle().String())
+	testza.AssertEqual(t, "101", rgb.ToBackgroundStyle().String())
+
+	t.Setenv("FORCE_COLOR", "0")
+	testza.AssertEqual(t, "Hello", rgb.ToStyle().Sprint("Hello"))
+}
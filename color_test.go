@@ -161,6 +161,12 @@ func TestColor_ToStyle(t *testing.T) {
 	testza.AssertEqual(t, s, &pterm.Style{pterm.FgCyan})
 }
 
+func TestNewRGBStyle(t *testing.T) {
+	s := pterm.NewRGBStyle(pterm.NewRGB(255, 128, 0), pterm.NewRGB(0, 0, 64))
+	testza.AssertEqual(t, "38;2;255;128;0;48;2;0;0;64", s.String())
+	testza.AssertEqual(t, "38;2;255;128;0", pterm.NewRGBStyle(pterm.NewRGB(255, 128, 0)).String())
+}
+
 func TestStyle_Add(t *testing.T) {
 	testza.AssertEqual(t, pterm.Style{pterm.FgRed, pterm.BgGreen}, pterm.Style{pterm.FgRed}.Add(pterm.Style{pterm.BgGreen}))
 	testza.AssertEqual(t, pterm.Style{pterm.FgRed, pterm.BgGreen, pterm.Bold}, pterm.Style{pterm.FgRed}.Add(pterm.Style{pterm.BgGreen}).Add(pterm.Style{pterm.Bold}))
@@ -192,3 +198,22 @@ func TestDisabledColorDoesPrintPlainString(t *testing.T) {
 	pterm.DisableColor()
 	testza.AssertEqual(t, "Hello, World!", pterm.FgRed.Sprint("Hello, World!"))
 }
+
+func TestStyle_SprintPadded(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	testza.AssertEqual(t, s.Sprint("abc")+"  ", s.SprintPadded(5, "abc"))
+	testza.AssertEqual(t, s.Sprint("漢字")+" ", s.SprintPadded(5, "漢字"))
+	// wider text is not shortened
+	testza.AssertEqual(t, s.Sprint("abcdef"), s.SprintPadded(3, "abcdef"))
+}
+
+func TestStyle_SprintTruncated(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	testza.AssertEqual(t, s.Sprint("abcd…"), s.SprintTruncated(5, "abcdefgh"))
+	testza.AssertEqual(t, s.Sprint("abc")+"  ", s.SprintTruncated(5, "abc"))
+	// double-width runes are never cut in half
+	testza.AssertEqual(t, s.Sprint("漢…")+" ", s.SprintTruncated(4, "漢字漢字"))
+	for _, text := range []string{"", "abc", "abcdefgh", "漢字漢字", pterm.Green("colored text")} {
+		testza.AssertEqual(t, 6, internal.DisplayWidth(s.SprintTruncated(6, text)), text)
+	}
+}
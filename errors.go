@@ -8,4 +8,46 @@ var (
 
 	// ErrHexCodeIsInvalid - the given HEX code is invalid.
 	ErrHexCodeIsInvalid = errors.New("hex code is not valid")
+
+	// ErrUnknownColor - the given color name is not known to PTerm.
+	ErrUnknownColor = errors.New("unknown color")
+
+	// ErrTimeout - an interactive printer was not answered in time and the default value is used.
+	ErrTimeout = errors.New("timeout reached - using default value")
+
+	// ErrColumnOutOfRange - the given column index does not exist in the table.
+	ErrColumnOutOfRange = errors.New("column index out of range")
+
+	// ErrNotAStructSlice - the given value is not a slice of structs.
+	ErrNotAStructSlice = errors.New("value is not a slice of structs")
+
+	// ErrNoOptions - an interactive printer has no options, which could be selected.
+	ErrNoOptions = errors.New("no options provided")
+
+	// ErrInvalidInput - the line, which was entered into an interactive printer, is not a valid answer.
+	ErrInvalidInput = errors.New("invalid input")
+
+	// ErrDuplicateShortcut - multiple options of an interactive printer have the same shortcut.
+	ErrDuplicateShortcut = errors.New("duplicate shortcut")
+
+	// ErrRawModeFailed - an interactive printer could not read single key presses, because the terminal could not be switched to raw mode.
+	ErrRawModeFailed = errors.New("failed to set raw mode")
 )
+
+// rawModeError is the error of a keyboard listener, which could not be started.
+// It matches ErrRawModeFailed and unwraps to the error of the keyboard package.
+type rawModeError struct {
+	err error
+}
+
+func (e rawModeError) Error() string {
+	return e.err.Error()
+}
+
+func (e rawModeError) Unwrap() error {
+	return e.err
+}
+
+func (e rawModeError) Is(target error) bool {
+	return target == ErrRawModeFailed
+}
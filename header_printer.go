@@ -75,6 +75,9 @@ func (p HeaderPrinter) Sprint(a ...interface{}) string {
 		p.BackgroundStyle = NewStyle()
 	}
 
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+
 	text := Sprint(a...)
 
 	var blankLine string
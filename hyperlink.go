@@ -0,0 +1,58 @@
+package pterm
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/atomic"
+)
+
+// PrintHyperlinks is false if PTerm should print hyperlinks as plain text.
+// It is detected automatically from the environment and can be overridden with EnableHyperlinks and DisableHyperlinks.
+var PrintHyperlinks = atomic.NewBool(detectHyperlinkSupport())
+
+// hyperlinkRegex matches the OSC 8 escape sequences, which start and end a hyperlink.
+var hyperlinkRegex = regexp.MustCompile("\x1b]8;[^\x1b\x07]*;[^\x1b\x07]*(\x1b\\\\|\x07)")
+
+// EnableHyperlinks enables the output of OSC 8 hyperlinks.
+func EnableHyperlinks() {
+	PrintHyperlinks.Store(true)
+}
+
+// DisableHyperlinks disables the output of OSC 8 hyperlinks. Hyperlinks are printed as plain text instead.
+func DisableHyperlinks() {
+	PrintHyperlinks.Store(false)
+}
+
+// Hyperlink returns the text as a clickable OSC 8 hyperlink to url.
+// If the terminal does not support hyperlinks, or RawOutput is enabled, the plain text is returned.
+func Hyperlink(text, url string) string {
+	if !PrintHyperlinks.Load() || RawOutput.Load() || url == "" {
+		return text
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// detectHyperlinkSupport returns true if the terminal is known to support OSC 8 hyperlinks.
+// FORCE_HYPERLINK can be set to "1" or "0" to override the detection.
+func detectHyperlinkSupport() bool {
+	if force, ok := os.LookupEnv("FORCE_HYPERLINK"); ok {
+		return force != "0"
+	}
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty":
+		return true
+	}
+	if os.Getenv("WT_SESSION") != "" || os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("DOMTERM") != "" {
+		return true
+	}
+	if version, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && version >= 5000 {
+		return true
+	}
+	return strings.HasPrefix(os.Getenv("TERM"), "xterm-kitty")
+}
@@ -0,0 +1,47 @@
+package pterm_test
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestHyperlink(t *testing.T) {
+	pterm.EnableHyperlinks()
+	defer pterm.DisableHyperlinks()
+
+	link := pterm.Hyperlink("pterm", "https://pterm.sh")
+	testza.AssertEqual(t, "\x1b]8;;https://pterm.sh\x1b\\pterm\x1b]8;;\x1b\\", link)
+	testza.AssertEqual(t, "pterm", pterm.RemoveColorFromString(link))
+}
+
+func TestHyperlink_Disabled(t *testing.T) {
+	pterm.DisableHyperlinks()
+	testza.AssertEqual(t, "pterm", pterm.Hyperlink("pterm", "https://pterm.sh"))
+}
+
+func TestHyperlink_RawOutput(t *testing.T) {
+	pterm.EnableHyperlinks()
+	defer pterm.DisableHyperlinks()
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	testza.AssertEqual(t, "pterm", pterm.Hyperlink("pterm", "https://pterm.sh"))
+}
+
+func TestHyperlink_Concurrent(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			pterm.EnableHyperlinks()
+			pterm.DisableHyperlinks()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		testza.AssertEqual(t, "pterm", pterm.RemoveColorFromString(pterm.Hyperlink("pterm", "https://pterm.sh")))
+	}
+	<-done
+}
@@ -1,18 +1,24 @@
 package pterm
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
+	"unicode"
 
 	"atomicgo.dev/cursor"
-	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
+	"go.uber.org/atomic"
+
 	"github.com/pterm/pterm/internal"
 )
 
 var (
 	// DefaultInteractiveConfirm is the default InteractiveConfirm printer.
 	// Pressing "y" will return true, "n" will return false.
+	// The keys can be changed with WithConfirmKeys and WithRejectKeys.
 	// Pressing enter without typing "y" or "n" will return the configured default value (by default set to "no").
 	DefaultInteractiveConfirm = InteractiveConfirmPrinter{
 		DefaultValue: false,
@@ -36,6 +42,12 @@ type InteractiveConfirmPrinter struct {
 	RejectText   string
 	RejectStyle  *Style
 	SuffixStyle  *Style
+	Timeout      time.Duration
+	ConfirmKeys  []rune
+	RejectKeys   []rune
+	// If stdin is not a terminal, the answer is read from a line instead.
+	// If FallbackDisabled is true, single key presses are read anyway.
+	FallbackDisabled bool
 }
 
 // WithDefaultText sets the default text.
@@ -86,13 +98,55 @@ func (p InteractiveConfirmPrinter) WithSuffixStyle(style *Style) *InteractiveCon
 	return &p
 }
 
+// WithConfirmKeys sets the keys, which confirm the prompt.
+// By default, the first letter of the ConfirmText is used.
+// Use '\r' for enter and '\x1b' for escape.
+func (p InteractiveConfirmPrinter) WithConfirmKeys(keys ...rune) *InteractiveConfirmPrinter {
+	p.ConfirmKeys = keys
+	return &p
+}
+
+// WithRejectKeys sets the keys, which reject the prompt.
+// By default, the first letter of the RejectText is used.
+// Use '\r' for enter and '\x1b' for escape.
+func (p InteractiveConfirmPrinter) WithRejectKeys(keys ...rune) *InteractiveConfirmPrinter {
+	p.RejectKeys = keys
+	return &p
+}
+
+// WithTimeout sets the duration after which the default value is returned, if no key was pressed.
+// A timeout of zero, or below, waits forever.
+func (p InteractiveConfirmPrinter) WithTimeout(timeout time.Duration) *InteractiveConfirmPrinter {
+	p.Timeout = timeout
+	return &p
+}
+
+// WithFallbackDisabled sets if single key presses should be read, even if stdin is not a terminal.
+// By default, the answer is read from a line in that case.
+func (p InteractiveConfirmPrinter) WithFallbackDisabled(b ...bool) *InteractiveConfirmPrinter {
+	p.FallbackDisabled = internal.WithBoolean(b)
+	return &p
+}
+
 // Show shows the confirm prompt.
 //
 // Example:
 //
 //	result, _ := pterm.DefaultInteractiveConfirm.Show("Are you sure?")
 //	pterm.Println(result)
+//
+// If a Timeout is set and no key was pressed in time, the default value is returned together with ErrTimeout.
 func (p InteractiveConfirmPrinter) Show(text ...string) (bool, error) {
+	return p.ShowWithContext(context.Background(), text...)
+}
+
+// ShowWithContext shows the confirm prompt, like Show.
+// If the context is done before an answer was given, the prompt stops and the error of the context is returned.
+func (p InteractiveConfirmPrinter) ShowWithContext(ctx context.Context, text ...string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
 	// should be the first defer statement to make sure it is executed last
 	// and all the needed cleanup can be done before
 	cancel, exit := internal.NewCancelationSignal()
@@ -105,58 +159,151 @@ func (p InteractiveConfirmPrinter) Show(text ...string) (bool, error) {
 	}
 
 	p.TextStyle.Print(text[0] + " " + p.getSuffix() + ": ")
-	y, n := p.getShortHandles()
+	confirmKeys, rejectKeys := p.getKeys()
+
+	if useLineInput(p.FallbackDisabled) {
+		return p.showLineInput(ctx, confirmKeys, rejectKeys)
+	}
+
+	var interrupted, answeredDefaultAfterTimeout bool
+	timedOut := atomic.NewBool(false)
+	answered := atomic.NewBool(false)
+	stopTimeout := stopKeyboardListenerAfter(p.Timeout, timedOut)
+	defer stopTimeout()
+	cancelled := atomic.NewBool(false)
+	stopOnDone := stopKeyboardListenerOnDone(ctx, cancelled)
+	defer stopOnDone()
 
-	var interrupted bool
-	err := keyboard.Listen(func(keyInfo keys.Key) (stop bool, err error) {
+	err := listenKeyboard(func(keyInfo keys.Key) (stop bool, err error) {
 		key := keyInfo.Code
-		char := strings.ToLower(keyInfo.String())
 		if err != nil {
 			return false, fmt.Errorf("failed to get key: %w", err)
 		}
 
-		switch key {
-		case keys.RuneKey:
-			switch char {
-			case y:
-				p.ConfirmStyle.Print(p.ConfirmText)
-				Println()
-				result = true
-				return true, nil
-			case n:
-				p.RejectStyle.Print(p.RejectText)
-				Println()
-				result = false
-				return true, nil
-			}
-		case keys.Enter:
-			if p.DefaultValue {
+		if cancelled.Load() {
+			return true, nil
+		}
+
+		if answered.Load() {
+			return true, nil
+		}
+
+		answer := func(value bool) (bool, error) {
+			answered.Store(true)
+			if value {
 				p.ConfirmStyle.Print(p.ConfirmText)
 			} else {
 				p.RejectStyle.Print(p.RejectText)
 			}
 			Println()
-			result = p.DefaultValue
+			result = value
 			return true, nil
-		case keys.CtrlC:
+		}
+
+		if timedOut.Load() {
+			answeredDefaultAfterTimeout = true
+			return answer(p.DefaultValue)
+		}
+
+		if key == keys.CtrlC {
 			cancel()
 			interrupted = true
 			return true, nil
 		}
+
+		if r, ok := keyToRune(keyInfo); ok {
+			switch {
+			case containsRune(confirmKeys, r):
+				return answer(true)
+			case containsRune(rejectKeys, r):
+				return answer(false)
+			}
+		}
+
+		if key == keys.Enter {
+			return answer(p.DefaultValue)
+		}
+
+		// unrecognized keys are ignored, so the user is prompted again
 		return false, nil
 	})
+	if rawModeFailed(err, p.FallbackDisabled) {
+		return p.showLineInput(ctx, confirmKeys, rejectKeys)
+	}
 	if !interrupted {
 		cursor.StartOfLine()
 	}
+	if err == nil && cancelled.Load() && !answered.Load() {
+		Println()
+		return false, ctx.Err()
+	}
+	if err == nil && answeredDefaultAfterTimeout {
+		err = ErrTimeout
+	}
 	return result, err
 }
 
+// showLineInput reads the answer from a line.
+// The answer can be one of the keys or the whole confirm or reject text. An empty line returns the default value.
+func (p InteractiveConfirmPrinter) showLineInput(ctx context.Context, confirmKeys, rejectKeys []rune) (bool, error) {
+	readCtx := ctx
+	if p.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		readCtx, cancelTimeout = context.WithTimeout(ctx, p.Timeout)
+		defer cancelTimeout()
+	}
+
+	line, err := readLine(readCtx)
+	if err != nil {
+		Println()
+		if ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+			return p.DefaultValue, ErrTimeout
+		}
+		return false, err
+	}
+
+	answer := strings.ToLower(strings.TrimSpace(line))
+	if answer == "" {
+		return p.DefaultValue, nil
+	}
+	if answer == strings.ToLower(p.ConfirmText) {
+		return true, nil
+	}
+	if answer == strings.ToLower(p.RejectText) {
+		return false, nil
+	}
+	if r := []rune(answer); len(r) == 1 {
+		switch {
+		case containsRune(confirmKeys, r[0]):
+			return true, nil
+		case containsRune(rejectKeys, r[0]):
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("%w: %q is neither %q nor %q", ErrInvalidInput, line, p.ConfirmText, p.RejectText)
+}
+
+// getKeys returns the keys, which confirm and reject the prompt.
+// If no keys are set, the first letters of the confirm and reject texts are used.
+func (p InteractiveConfirmPrinter) getKeys() (confirm, reject []rune) {
+	confirm, reject = p.ConfirmKeys, p.RejectKeys
+	if len(confirm) == 0 {
+		if r, ok := firstRune(p.ConfirmText); ok {
+			confirm = []rune{r}
+		}
+	}
+	if len(reject) == 0 {
+		if r, ok := firstRune(p.RejectText); ok {
+			reject = []rune{r}
+		}
+	}
+	return confirm, reject
+}
+
 // getShortHandles returns the short hand answers for the confirmation prompt
 func (p InteractiveConfirmPrinter) getShortHandles() (string, string) {
-	y := strings.ToLower(string([]rune(p.ConfirmText)[0]))
-	n := strings.ToLower(string([]rune(p.RejectText)[0]))
-
-	return y, n
+	confirm, reject := p.getKeys()
+	return keyName(confirm), keyName(reject)
 }
 
 // getSuffix returns the confirmation prompt suffix
@@ -170,3 +317,49 @@ func (p InteractiveConfirmPrinter) getSuffix() string {
 
 	return p.SuffixStyle.Sprintf("[%s/%s]", y, n)
 }
+
+// firstRune returns the lower case first rune of s.
+func firstRune(s string) (rune, bool) {
+	for _, r := range s {
+		return unicode.ToLower(r), true
+	}
+	return 0, false
+}
+
+// keyToRune returns the lower case rune of a pressed key.
+// Control keys, like enter or escape, are returned as their control character.
+func keyToRune(key keys.Key) (rune, bool) {
+	switch {
+	case key.Code == keys.RuneKey && len(key.Runes) > 0:
+		return unicode.ToLower(key.Runes[0]), true
+	case key.Code == keys.Space:
+		return ' ', true
+	case key.Code >= 0:
+		return rune(key.Code), true
+	}
+	return 0, false
+}
+
+// keyName returns the displayed name of the first key in a key set.
+func keyName(set []rune) string {
+	if len(set) == 0 {
+		return ""
+	}
+	switch r := set[0]; {
+	case r == ' ':
+		return "space"
+	case r < ' ' || r == 127:
+		return keys.Key{Code: keys.KeyCode(r)}.String()
+	default:
+		return string(unicode.ToLower(r))
+	}
+}
+
+func containsRune(set []rune, r rune) bool {
+	for _, s := range set {
+		if unicode.ToLower(s) == r {
+			return true
+		}
+	}
+	return false
+}
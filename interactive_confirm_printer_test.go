@@ -3,7 +3,12 @@
 package pterm_test
 
 import (
+	"context"
+	"io"
+	"os"
+	"runtime"
 	"testing"
+	"time"
 
 	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
@@ -51,6 +56,65 @@ func TestInteractiveConfirmPrinter_WithDefaultValue_true(t *testing.T) {
 	testza.AssertTrue(t, result)
 }
 
+func TestInteractiveConfirmPrinter_WithTimeout_true(t *testing.T) {
+	p := pterm.DefaultInteractiveConfirm.WithDefaultValue(true).WithTimeout(time.Millisecond * 100)
+	result, err := p.Show()
+	testza.AssertTrue(t, result)
+	testza.AssertErrorIs(t, err, pterm.ErrTimeout)
+}
+
+func TestInteractiveConfirmPrinter_WithTimeout_false(t *testing.T) {
+	p := pterm.DefaultInteractiveConfirm.WithDefaultValue(false).WithTimeout(time.Millisecond * 100)
+	result, err := p.Show()
+	testza.AssertFalse(t, result)
+	testza.AssertErrorIs(t, err, pterm.ErrTimeout)
+}
+
+func TestInteractiveConfirmPrinter_WithTimeout_answered(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress('y')
+	}()
+	p := pterm.DefaultInteractiveConfirm.WithTimeout(time.Second)
+	result, err := p.Show()
+	testza.AssertTrue(t, result)
+	testza.AssertNoError(t, err)
+}
+
+func TestInteractiveConfirmPrinter_WithTimeout_AfterAnswer(t *testing.T) {
+	time.Sleep(10 * time.Millisecond)
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 20; i++ {
+		pressed := make(chan struct{})
+		go func() {
+			keyboard.SimulateKeyPress('y')
+			close(pressed)
+		}()
+		result, err := pterm.DefaultInteractiveConfirm.WithTimeout(time.Millisecond).Show()
+		if err != nil {
+			// the answer is never mixed up with the timeout
+			testza.AssertErrorIs(t, err, pterm.ErrTimeout)
+			testza.AssertFalse(t, result)
+			select {
+			case <-pressed:
+			case <-time.After(10 * time.Millisecond):
+				_, _ = pterm.DefaultInteractiveConfirm.Show()
+			}
+		} else {
+			testza.AssertTrue(t, result)
+		}
+	}
+
+	// a timeout, which is reached after the answer, doesn't simulate a key press for the next prompt
+	go func() {
+		keyboard.SimulateKeyPress('n')
+	}()
+	result, err := pterm.DefaultInteractiveConfirm.Show()
+	testza.AssertFalse(t, result)
+	testza.AssertNoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	testza.AssertTrue(t, runtime.NumGoroutine() <= goroutines+2, runtime.NumGoroutine()-goroutines)
+}
+
 func TestInteractiveConfirmPrinter_WithConfirmStyle(t *testing.T) {
 	style := pterm.NewStyle(pterm.FgRed)
 	p := pterm.DefaultInteractiveConfirm.WithConfirmStyle(style)
@@ -117,6 +181,63 @@ func TestInteractiveConfirmPrinter_CustomAnswers(t *testing.T) {
 	}
 }
 
+func TestInteractiveConfirmPrinter_WithConfirmKeys(t *testing.T) {
+	p := pterm.DefaultInteractiveConfirm.WithConfirmKeys('j', '\r')
+	testza.AssertEqual(t, []rune{'j', '\r'}, p.ConfirmKeys)
+}
+
+func TestInteractiveConfirmPrinter_WithRejectKeys(t *testing.T) {
+	p := pterm.DefaultInteractiveConfirm.WithRejectKeys('n', '\x1b')
+	testza.AssertEqual(t, []rune{'n', '\x1b'}, p.RejectKeys)
+}
+
+func TestInteractiveConfirmPrinter_CustomKeys(t *testing.T) {
+	p := pterm.DefaultInteractiveConfirm.WithConfirmText("Ja").WithRejectText("Nein").WithConfirmKeys('j', '\r').WithRejectKeys('n', '\x1b')
+	tests := []struct {
+		name     string
+		key      interface{}
+		expected bool
+	}{
+		{name: "Confirm_rune", key: 'j', expected: true},
+		{name: "Confirm_upper_case", key: 'J', expected: true},
+		{name: "Confirm_enter", key: keys.Enter, expected: true},
+		{name: "Reject_rune", key: 'n', expected: false},
+		{name: "Reject_escape", key: keys.Escape, expected: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			go func() {
+				keyboard.SimulateKeyPress(tc.key)
+			}()
+			result, _ := p.Show()
+			testza.AssertEqual(t, tc.expected, result)
+		})
+	}
+}
+
+func TestInteractiveConfirmPrinter_CustomKeysOutput(t *testing.T) {
+	p := pterm.DefaultInteractiveConfirm.WithConfirmText("Ja").WithRejectText("Nein").WithConfirmKeys('\r').WithRejectKeys('\x1b')
+	out := captureStdout(func(w io.Writer) {
+		go func() {
+			keyboard.SimulateKeyPress(keys.Escape)
+		}()
+		result, _ := p.Show("Weiter?")
+		testza.AssertFalse(t, result)
+	})
+	out = pterm.RemoveColorFromString(out)
+	testza.AssertContains(t, out, "Weiter? [enter/ESC]: ")
+	testza.AssertContains(t, out, "Nein")
+}
+
+func TestInteractiveConfirmPrinter_UnrecognizedKeyReprompts(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress('x')
+		keyboard.SimulateKeyPress('y')
+	}()
+	result, _ := pterm.DefaultInteractiveConfirm.Show()
+	testza.AssertTrue(t, result)
+}
+
 func TestInteractiveConfirmPrinter_WithSuffixStyle(t *testing.T) {
 	style := pterm.NewStyle(pterm.FgRed)
 	p := pterm.DefaultInteractiveConfirm.WithSuffixStyle(style)
@@ -128,3 +249,127 @@ func TestInteractiveConfirmPrinter_WithTextStyle(t *testing.T) {
 	p := pterm.DefaultInteractiveConfirm.WithTextStyle(style)
 	testza.AssertEqual(t, p.TextStyle, style)
 }
+
+func TestInteractiveConfirmPrinter_WithTimeout(t *testing.T) {
+	p := pterm.DefaultInteractiveConfirm.WithTimeout(time.Second)
+	testza.AssertEqual(t, time.Second, p.Timeout)
+}
+
+func TestInteractiveConfirmPrinter_ShowWithContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result, err := pterm.DefaultInteractiveConfirm.ShowWithContext(ctx)
+	testza.AssertFalse(t, result)
+	testza.AssertErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInteractiveConfirmPrinter_ShowWithContext_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := pterm.DefaultInteractiveConfirm.ShowWithContext(ctx)
+	testza.AssertFalse(t, result)
+	testza.AssertErrorIs(t, err, context.Canceled)
+}
+
+func TestInteractiveConfirmPrinter_ShowWithContext_CancelAfterAnswer(t *testing.T) {
+	time.Sleep(10 * time.Millisecond)
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 20; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			keyboard.SimulateKeyPress('y')
+			cancel()
+		}()
+		_, _ = pterm.DefaultInteractiveConfirm.ShowWithContext(ctx)
+		cancel()
+	}
+
+	// no simulated key press is left behind, which blocks or is received by the next prompt
+	go func() {
+		keyboard.SimulateKeyPress('n')
+	}()
+	result, err := pterm.DefaultInteractiveConfirm.Show()
+	testza.AssertFalse(t, result)
+	testza.AssertNoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	testza.AssertTrue(t, runtime.NumGoroutine() <= goroutines+2, runtime.NumGoroutine()-goroutines)
+}
+
+func TestInteractiveConfirmPrinter_ShowWithContext_NextPromptGetsInput(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := pterm.DefaultInteractiveConfirm.ShowWithContext(ctx)
+	testza.AssertErrorIs(t, err, context.DeadlineExceeded)
+
+	go func() {
+		keyboard.SimulateKeyPress('y')
+	}()
+	result, err := pterm.DefaultInteractiveConfirm.Show()
+	testza.AssertNoError(t, err)
+	testza.AssertTrue(t, result)
+}
+
+func TestInteractiveConfirmPrinter_WithFallbackDisabled(t *testing.T) {
+	p := pterm.DefaultInteractiveConfirm.WithFallbackDisabled()
+	testza.AssertTrue(t, p.FallbackDisabled)
+	testza.AssertFalse(t, pterm.DefaultInteractiveConfirm.FallbackDisabled)
+}
+
+func TestInteractiveConfirmPrinter_LineInput(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"y\n", true},
+		{"Yes\r\n", true},
+		{" n \n", false},
+		{"no\n", false},
+		{"\n", true},
+		{"", true},
+	}
+	for _, tc := range tests {
+		simulateLineInput(t, tc.input)
+		result, err := pterm.DefaultInteractiveConfirm.WithDefaultValue(true).Show()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, tc.expected, result, tc.input)
+	}
+}
+
+func TestInteractiveConfirmPrinter_LineInputInvalid(t *testing.T) {
+	simulateLineInput(t, "maybe\n")
+	_, err := pterm.DefaultInteractiveConfirm.Show()
+	testza.AssertErrorIs(t, err, pterm.ErrInvalidInput)
+}
+
+func TestInteractiveConfirmPrinter_LineInputReadsOneLine(t *testing.T) {
+	simulateLineInput(t, "y\nn\n")
+	first, _ := pterm.DefaultInteractiveConfirm.Show()
+	second, _ := pterm.DefaultInteractiveConfirm.Show()
+	testza.AssertTrue(t, first)
+	testza.AssertFalse(t, second)
+}
+
+func TestInteractiveConfirmPrinter_LineInputAfterTimeout(t *testing.T) {
+	r, w, err := os.Pipe()
+	testza.AssertNoError(t, err)
+	stdin := os.Stdin
+	os.Stdin = r
+	pterm.SetForceRawInput(false)
+	defer func() {
+		pterm.SetForceRawInput(true)
+		os.Stdin = stdin
+		r.Close()
+		w.Close()
+	}()
+
+	_, err = pterm.DefaultInteractiveConfirm.WithTimeout(50 * time.Millisecond).Show()
+	testza.AssertErrorIs(t, err, pterm.ErrTimeout)
+
+	// the line, which was pending while the first prompt timed out, is the answer of the next prompt
+	_, err = w.WriteString("y\nn\n")
+	testza.AssertNoError(t, err)
+	first, _ := pterm.DefaultInteractiveConfirm.Show()
+	second, _ := pterm.DefaultInteractiveConfirm.Show()
+	testza.AssertTrue(t, first)
+	testza.AssertFalse(t, second)
+}
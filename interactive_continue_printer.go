@@ -1,13 +1,14 @@
 package pterm
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
 
 	"atomicgo.dev/cursor"
-	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
+	"go.uber.org/atomic"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
@@ -115,7 +116,18 @@ func (p InteractiveContinuePrinter) WithSuffixStyle(style *Style) *InteractiveCo
 //	result, _ := pterm.DefaultInteractiveContinue.Show("Do you want to apply the changes?")
 //	pterm.Println(result)
 func (p InteractiveContinuePrinter) Show(text ...string) (string, error) {
+	return p.ShowWithContext(context.Background(), text...)
+}
+
+// ShowWithContext shows the continue prompt, like Show.
+// If the context is done before an answer was given, the prompt stops and the error of the context is returned.
+func (p InteractiveContinuePrinter) ShowWithContext(ctx context.Context, text ...string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
 	var result string
+	answered := atomic.NewBool(false)
 
 	if len(text) == 0 || text[0] == "" {
 		text = []string{p.DefaultText}
@@ -123,10 +135,17 @@ func (p InteractiveContinuePrinter) Show(text ...string) (string, error) {
 
 	p.TextStyle.Print(text[0] + " " + p.getSuffix() + ": ")
 
-	err := keyboard.Listen(func(keyInfo keys.Key) (stop bool, err error) {
+	cancelled := atomic.NewBool(false)
+	stopOnDone := stopKeyboardListenerOnDone(ctx, cancelled)
+	defer stopOnDone()
+
+	err := listenKeyboard(func(keyInfo keys.Key) (stop bool, err error) {
 		if err != nil {
 			return false, fmt.Errorf("failed to get key: %w", err)
 		}
+		if cancelled.Load() {
+			return true, nil
+		}
 		key := keyInfo.Code
 		char := keyInfo.String()
 
@@ -140,6 +159,7 @@ func (p InteractiveContinuePrinter) Show(text ...string) (string, error) {
 					p.OptionsStyle.Print(p.Options[i])
 					Println()
 					result = p.Options[i]
+					answered.Store(true)
 					return true, nil
 				}
 			}
@@ -147,6 +167,7 @@ func (p InteractiveContinuePrinter) Show(text ...string) (string, error) {
 			p.OptionsStyle.Print(p.Options[p.DefaultValueIndex])
 			Println()
 			result = p.Options[p.DefaultValueIndex]
+			answered.Store(true)
 			return true, nil
 		case keys.CtrlC:
 			os.Exit(1)
@@ -155,6 +176,10 @@ func (p InteractiveContinuePrinter) Show(text ...string) (string, error) {
 		return false, nil
 	})
 	cursor.StartOfLine()
+	if err == nil && !answered.Load() {
+		Println()
+		return "", ctx.Err()
+	}
 	return result, err
 }
 
@@ -3,7 +3,9 @@
 package pterm_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
@@ -183,3 +185,19 @@ func TestInteractiveContinuePrinter_WithTextStyle(t *testing.T) {
 	p := pterm.DefaultInteractiveContinue.WithTextStyle(style)
 	testza.AssertEqual(t, p.TextStyle, style)
 }
+
+func TestInteractiveContinuePrinter_ShowWithContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result, err := pterm.DefaultInteractiveContinue.ShowWithContext(ctx)
+	testza.AssertEqual(t, "", result)
+	testza.AssertErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInteractiveContinuePrinter_ShowWithContext_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := pterm.DefaultInteractiveContinue.ShowWithContext(ctx)
+	testza.AssertEqual(t, "", result)
+	testza.AssertErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,213 @@
+package pterm
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"atomicgo.dev/keyboard"
+	"atomicgo.dev/keyboard/keys"
+	"go.uber.org/atomic"
+)
+
+// listenerStopKey is simulated to stop a running keyboard listener. It can't be typed by the user.
+var listenerStopKey = keys.Key{Code: keys.Null, AltPressed: true}
+
+// keyboardListener tracks the keyboard listener of the running interactive printer,
+// so that listenerStopKey is only simulated, while the listener can receive it.
+// A simulated key press blocks until the listener receives it, so the listener doesn't stop,
+// before it received every simulated key press, which was started while it was running.
+var keyboardListener struct {
+	sync.Mutex
+	// generation is increased, whenever a listener starts.
+	generation int
+	// stopping is true, after the listener callback returned true, until the next listener starts.
+	stopping bool
+	// pending is the number of simulated key presses, which were not received yet.
+	pending int
+	// stopRequested is the generation of a listener, which was stopped before it started.
+	stopRequested int
+	// simulate passes a simulated key press to the running listener.
+	simulate func(key keys.Key)
+}
+
+// listenKeyboard runs keyboard.Listen with onKeyPress.
+// Use it instead of keyboard.Listen, if the listener can be stopped with stopKeyboardListenerOnDone or stopKeyboardListenerAfter.
+// If the listener fails before it received a key press, the terminal could not be switched to raw mode,
+// or there is no terminal at all, and the returned error matches ErrRawModeFailed.
+func listenKeyboard(onKeyPress func(key keys.Key) (stop bool, err error)) error {
+	if !startListener(func(key keys.Key) { _ = keyboard.SimulateKeyPress(key) }) {
+		if stop, err := onKeyPress(listenerStopKey); err != nil || stop {
+			return err
+		}
+	}
+	defer stopListener()
+
+	// Simulated key presses are handled in another goroutine than typed ones, so the handling is serialized.
+	var callback listenerCallback
+	var received atomic.Bool
+	err := keyboard.Listen(func(key keys.Key) (bool, error) {
+		received.Store(true)
+		return callback.call(isListenerStopKey(key), func() (bool, error) {
+			return onKeyPress(key)
+		})
+	})
+	// The errors of onKeyPress can only be returned after a key press was received.
+	if err != nil && !received.Load() {
+		return rawModeError{err: err}
+	}
+	return err
+}
+
+// startListener marks a new listener as running, which receives simulated key presses with simulate.
+// It returns false, if the listener was stopped before it started, so that the callback has to be called with listenerStopKey first.
+func startListener(simulate func(key keys.Key)) bool {
+	keyboardListener.Lock()
+	defer keyboardListener.Unlock()
+	keyboardListener.generation++
+	keyboardListener.stopping = false
+	keyboardListener.simulate = simulate
+	return keyboardListener.stopRequested != keyboardListener.generation
+}
+
+// stopListener marks the running listener as stopped, so that no key presses are simulated for it anymore.
+func stopListener() {
+	keyboardListener.Lock()
+	defer keyboardListener.Unlock()
+	keyboardListener.stopping = true
+	keyboardListener.simulate = nil
+}
+
+// isListenerStopKey returns true, if the key is listenerStopKey.
+func isListenerStopKey(key keys.Key) bool {
+	return key.Code == listenerStopKey.Code && key.AltPressed && len(key.Runes) == 0
+}
+
+// listenerCallback serializes the calls of a listener callback.
+// After the callback stopped the listener, it keeps the listener running, until the pending simulated key presses were received.
+type listenerCallback struct {
+	sync.Mutex
+}
+
+// call calls callback, unless the listener is already stopping, and returns if the listener should stop.
+// simulated has to be true, if the callback is called for a simulated listenerStopKey.
+func (c *listenerCallback) call(simulated bool, callback func() (stop bool, err error)) (bool, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	keyboardListener.Lock()
+	if simulated {
+		keyboardListener.pending--
+	}
+	stopping := keyboardListener.stopping
+	keyboardListener.Unlock()
+
+	if !stopping {
+		stop, err := callback()
+		if err != nil || !stop {
+			return stop, err
+		}
+	}
+
+	keyboardListener.Lock()
+	defer keyboardListener.Unlock()
+	keyboardListener.stopping = true
+	// The listener keeps running, until it received the pending key presses, so that they don't block forever.
+	return keyboardListener.pending == 0, nil
+}
+
+// nextListenerGeneration returns the generation of the keyboard listener, which is started next.
+func nextListenerGeneration() int {
+	keyboardListener.Lock()
+	defer keyboardListener.Unlock()
+	return keyboardListener.generation + 1
+}
+
+// simulateListenerStop sets stopped to true and stops the listener of the given generation,
+// if it has not stopped yet and done is not closed.
+// If the listener is running, listenerStopKey is simulated. If it has not started yet, it is stopped as soon as it starts.
+func simulateListenerStop(generation int, done chan struct{}, stopped *atomic.Bool) {
+	keyboardListener.Lock()
+	select {
+	case <-done:
+		keyboardListener.Unlock()
+		return
+	default:
+	}
+	if keyboardListener.generation < generation {
+		stopped.Store(true)
+		keyboardListener.stopRequested = generation
+		keyboardListener.Unlock()
+		return
+	}
+	if keyboardListener.generation > generation || keyboardListener.stopping {
+		keyboardListener.Unlock()
+		return
+	}
+	stopped.Store(true)
+	keyboardListener.pending++
+	simulate := keyboardListener.simulate
+	keyboardListener.Unlock()
+
+	simulate(listenerStopKey)
+}
+
+// stopSimulating returns a function, which closes done, so that no key press is simulated anymore afterwards.
+func stopSimulating(done chan struct{}) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			keyboardListener.Lock()
+			defer keyboardListener.Unlock()
+			close(done)
+		})
+	}
+}
+
+// stopKeyboardListenerOnDone stops the next keyboard listener, which is started with listenKeyboard, when the context is done.
+// When the context is done, cancelled is set to true and a key press is simulated,
+// so that the listener callback can stop the listener.
+// Nothing is done, if the listener already stopped by itself.
+// The returned function has to be called after the listener stopped. No key press is simulated afterwards.
+func stopKeyboardListenerOnDone(ctx context.Context, cancelled *atomic.Bool) func() {
+	if ctx.Done() == nil {
+		return func() {}
+	}
+
+	generation := nextListenerGeneration()
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			simulateListenerStop(generation, done, cancelled)
+		case <-done:
+		}
+	}()
+
+	return stopSimulating(done)
+}
+
+// stopKeyboardListenerAfter stops the next keyboard listener, which is started with listenKeyboard, after the timeout has passed.
+// When the timeout is reached, timedOut is set to true and a key press is simulated,
+// so that the listener callback can stop the listener.
+// Nothing is done, if the listener already stopped by itself.
+// The returned function has to be called after the listener stopped. No key press is simulated afterwards.
+func stopKeyboardListenerAfter(timeout time.Duration, timedOut *atomic.Bool) func() {
+	if timeout <= 0 {
+		return func() {}
+	}
+
+	generation := nextListenerGeneration()
+	done := make(chan struct{})
+	timer := time.NewTimer(timeout)
+	go func() {
+		select {
+		case <-timer.C:
+			simulateListenerStop(generation, done, timedOut)
+		case <-done:
+			timer.Stop()
+		}
+	}()
+
+	return stopSimulating(done)
+}
@@ -0,0 +1,100 @@
+package pterm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// useLineInput returns true, if an interactive printer has to fall back to reading whole lines,
+// because stdin is not a terminal, which could be switched to raw mode.
+// If the terminal detection was overridden (see IsTerminal), single key presses are read, until rawModeFailed.
+func useLineInput(fallbackDisabled bool) bool {
+	if fallbackDisabled || ForceRawInput.Load() || forcedTTY() {
+		return false
+	}
+	return !term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// rawModeFailed returns true, if listenKeyboard returned err, because the terminal could not be switched to raw mode,
+// or because there is no terminal at all.
+// The interactive printers fall back to reading whole lines in this case, like useLineInput.
+func rawModeFailed(err error, fallbackDisabled bool) bool {
+	if err == nil || fallbackDisabled || ForceRawInput.Load() {
+		return false
+	}
+	return errors.Is(err, ErrRawModeFailed)
+}
+
+// lineResult is a line, which was read from stdin.
+type lineResult struct {
+	line string
+	err  error
+}
+
+// pendingLine receives the line, which is currently read from stdin.
+// If a prompt stops waiting for it, because its context is done, the next prompt receives it instead,
+// so that only a single line is read from stdin at a time.
+var pendingLine struct {
+	sync.Mutex
+	result chan lineResult
+	// stdin is the file, from which the line is read. A line from a replaced stdin is not used anymore.
+	stdin *os.File
+}
+
+// readLine reads a single line from stdin and returns it without the line break.
+// Stdin is read byte by byte, so that the lines after it are left for the following prompts.
+// If stdin is closed before a line break, the text read until then is returned.
+// If the context is done first, its error is returned, and the line is returned by the next call instead.
+func readLine(ctx context.Context) (string, error) {
+	pendingLine.Lock()
+	result := pendingLine.result
+	if result == nil || pendingLine.stdin != os.Stdin {
+		result = make(chan lineResult, 1)
+		pendingLine.result = result
+		pendingLine.stdin = os.Stdin
+		go readStdinLine(os.Stdin, result)
+	}
+	pendingLine.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case r := <-result:
+		pendingLine.Lock()
+		if pendingLine.result == result {
+			pendingLine.result = nil
+		}
+		pendingLine.Unlock()
+		return r.line, r.err
+	}
+}
+
+// readStdinLine reads a single line from stdin and sends it to result.
+func readStdinLine(stdin *os.File, result chan<- lineResult) {
+	var line []byte
+	var b [1]byte
+	for {
+		n, err := stdin.Read(b[:])
+		if n > 0 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			result <- lineResult{err: err}
+			return
+		}
+	}
+	result <- lineResult{line: strings.TrimSuffix(string(line), "\r")}
+}
@@ -0,0 +1,139 @@
+package pterm
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"atomicgo.dev/keyboard/keys"
+	"github.com/containerd/console"
+	"golang.org/x/term"
+
+	"github.com/pterm/pterm/internal"
+)
+
+// listenKeyboardAndMouse runs listenKeyboard, but also enables SGR mouse reporting, while it runs, if stdin is a terminal.
+// Clicks are passed to onClick together with the row of the cursor, so that the clicked row can be mapped onto the printed content.
+// The mouse wheel is passed to onKeyPress as keys.Up and keys.Down.
+// If the terminal can't be read directly, like on Windows, only the keyboard is used.
+func listenKeyboardAndMouse(onKeyPress func(key keys.Key) (stop bool, err error), onClick func(event internal.MouseEvent, cursorRow int) (stop bool, err error)) error {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return listenKeyboard(onKeyPress)
+	}
+	con, err := console.ConsoleFromFile(os.Stdin)
+	if err != nil {
+		return listenKeyboard(onKeyPress)
+	}
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return listenKeyboard(onKeyPress)
+	}
+	defer tty.Close()
+	if err := con.SetRaw(); err != nil {
+		return listenKeyboard(onKeyPress)
+	}
+	defer con.Reset()
+
+	if _, err := tty.WriteString(internal.EnableMouseReporting); err != nil {
+		return fmt.Errorf("failed to enable mouse reporting: %w", err)
+	}
+	defer tty.WriteString(internal.DisableMouseReporting)
+
+	simulated := make(chan keys.Key)
+	if !startListener(func(key keys.Key) { simulated <- key }) {
+		if stop, err := onKeyPress(listenerStopKey); err != nil || stop {
+			stopListener()
+			return err
+		}
+	}
+	defer stopListener()
+
+	inputs := make(chan internal.TerminalInput)
+	quit := make(chan struct{})
+	defer close(quit)
+	go readTerminalInput(tty, inputs, quit)
+
+	var callback listenerCallback
+	keyPress := func(key keys.Key) func() (bool, error) {
+		return func() (bool, error) {
+			return onKeyPress(key)
+		}
+	}
+	var click *internal.MouseEvent
+	for {
+		var stop bool
+		var err error
+		select {
+		case key := <-simulated:
+			stop, err = callback.call(true, keyPress(key))
+		case input, ok := <-inputs:
+			if !ok {
+				return errors.New("failed to read from the terminal")
+			}
+			switch {
+			case input.Mouse == nil && input.CursorRow == 0:
+				stop, err = callback.call(false, keyPress(input.Key))
+			case input.Mouse == nil && click != nil:
+				event, cursorRow := *click, input.CursorRow
+				click = nil
+				stop, err = callback.call(false, func() (bool, error) {
+					return onClick(event, cursorRow)
+				})
+			case input.Mouse == nil:
+				// the cursor position was not requested by a click
+			case input.Mouse.Button == internal.MouseLeft && !input.Mouse.Released:
+				// the clicked row is mapped, when the position of the cursor is reported
+				click = input.Mouse
+				_, _ = tty.WriteString(internal.RequestCursorPosition)
+			case input.Mouse.Button == internal.MouseWheelUp:
+				stop, err = callback.call(false, keyPress(keys.Key{Code: keys.Up}))
+			case input.Mouse.Button == internal.MouseWheelDown:
+				stop, err = callback.call(false, keyPress(keys.Key{Code: keys.Down}))
+			}
+		}
+		if err != nil || stop {
+			return err
+		}
+	}
+}
+
+// clickedLine returns the index of the line of the content, which is drawn in the clicked row, or -1.
+// The cursor has to be in the row below the content, where the AreaPrinter leaves it.
+func clickedLine(content string, row, cursorRow int) int {
+	width := GetTerminalWidth()
+	top := cursorRow - terminalRows(content, width)
+	if row < top {
+		return -1
+	}
+	for i, line := range strings.Split(content, "\n") {
+		top += terminalRows(line, width)
+		if row < top {
+			return i
+		}
+	}
+	return -1
+}
+
+// readTerminalInput reads the terminal and sends the parsed inputs, until reading fails or quit is closed.
+// inputs is closed, if reading fails.
+func readTerminalInput(tty *os.File, inputs chan<- internal.TerminalInput, quit <-chan struct{}) {
+	buf := make([]byte, 256)
+	var rest []byte
+	for {
+		n, err := tty.Read(buf)
+		if err != nil {
+			close(inputs)
+			return
+		}
+		var parsed []internal.TerminalInput
+		parsed, rest = internal.ParseTerminalInput(append(rest, buf[:n]...))
+		for _, input := range parsed {
+			select {
+			case inputs <- input:
+			case <-quit:
+				return
+			}
+		}
+	}
+}
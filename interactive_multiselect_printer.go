@@ -1,13 +1,15 @@
 package pterm
 
 import (
+	"context"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
-	"atomicgo.dev/cursor"
-	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
 	"github.com/lithammer/fuzzysearch/fuzzy"
+	"go.uber.org/atomic"
 
 	"github.com/pterm/pterm/internal"
 )
@@ -26,6 +28,9 @@ var (
 		Filter:         true,
 		KeySelect:      keys.Enter,
 		KeyConfirm:     keys.Tab,
+		KeySelectAll:   keys.Right,
+		KeySelectNone:  keys.Left,
+		KeyInvert:      keys.CtrlR,
 		Checkmark:      &ThemeDefault.Checkmark,
 	}
 )
@@ -37,23 +42,42 @@ type InteractiveMultiselectPrinter struct {
 	Options        []string
 	OptionStyle    *Style
 	DefaultOptions []string
-	MaxHeight      int
-	Selector       string
-	SelectorStyle  *Style
-	Filter         bool
-	Checkmark      *Checkmark
+	// DefaultSelected contains the indices of the Options, which are selected when the menu is shown.
+	DefaultSelected []int
+	MaxHeight       int
+	Selector        string
+	SelectorStyle   *Style
+	Filter          bool
+	Checkmark       *Checkmark
+	MinSelected     int
+	MaxSelected     int
+	// If ReplaceOldestSelection is true, selecting an option while MaxSelected options are selected
+	// deselects the oldest selected option. Otherwise, the selection is ignored.
+	ReplaceOldestSelection bool
+	// If stdin is not a terminal, the numbers of the options are read from a line instead.
+	// If FallbackDisabled is true, single key presses are read anyway.
+	FallbackDisabled bool
+	// If Mouse is true, an option can be selected and deselected by clicking it and the options can be scrolled with the mouse wheel,
+	// if the terminal supports SGR mouse reporting. The keyboard can be used at the same time.
+	Mouse bool
 
 	selectedOption        int
 	selectedOptions       []int
 	text                  string
 	fuzzySearchString     string
 	fuzzySearchMatches    []string
+	fuzzySearchIndices    []int
 	displayedOptions      []string
 	displayedOptionsStart int
 	displayedOptionsEnd   int
 
-	KeySelect  keys.KeyCode
-	KeyConfirm keys.KeyCode
+	KeySelect     keys.KeyCode
+	KeyConfirm    keys.KeyCode
+	KeySelectAll  keys.KeyCode
+	KeySelectNone keys.KeyCode
+	KeyInvert     keys.KeyCode
+
+	showConstraintHint bool
 }
 
 // WithOptions sets the options.
@@ -68,6 +92,13 @@ func (p InteractiveMultiselectPrinter) WithDefaultOptions(options []string) *Int
 	return &p
 }
 
+// WithDefaultSelected sets the indices of the options, which are selected when the menu is shown.
+// Unlike WithDefaultOptions, this works with options, which are not unique.
+func (p InteractiveMultiselectPrinter) WithDefaultSelected(indices []int) *InteractiveMultiselectPrinter {
+	p.DefaultSelected = indices
+	return &p
+}
+
 // WithDefaultText sets the default text.
 func (p InteractiveMultiselectPrinter) WithDefaultText(text string) *InteractiveMultiselectPrinter {
 	p.DefaultText = text
@@ -98,6 +129,59 @@ func (p InteractiveMultiselectPrinter) WithKeyConfirm(keyConfirm keys.KeyCode) *
 	return &p
 }
 
+// WithKeySelectAll sets the key, which selects all options, or as many as MaxSelected allows
+func (p InteractiveMultiselectPrinter) WithKeySelectAll(keySelectAll keys.KeyCode) *InteractiveMultiselectPrinter {
+	p.KeySelectAll = keySelectAll
+	return &p
+}
+
+// WithKeySelectNone sets the key, which deselects all options
+func (p InteractiveMultiselectPrinter) WithKeySelectNone(keySelectNone keys.KeyCode) *InteractiveMultiselectPrinter {
+	p.KeySelectNone = keySelectNone
+	return &p
+}
+
+// WithKeyInvert sets the key, which inverts the selection
+func (p InteractiveMultiselectPrinter) WithKeyInvert(keyInvert keys.KeyCode) *InteractiveMultiselectPrinter {
+	p.KeyInvert = keyInvert
+	return &p
+}
+
+// WithMinSelected sets the minimum amount of options, which have to be selected before confirming.
+// A value of zero, or below, disables the constraint.
+func (p InteractiveMultiselectPrinter) WithMinSelected(min int) *InteractiveMultiselectPrinter {
+	p.MinSelected = min
+	return &p
+}
+
+// WithMaxSelected sets the maximum amount of options, which can be selected.
+// A value of zero, or below, disables the constraint.
+func (p InteractiveMultiselectPrinter) WithMaxSelected(max int) *InteractiveMultiselectPrinter {
+	p.MaxSelected = max
+	return &p
+}
+
+// WithReplaceOldestSelection sets if selecting an option while MaxSelected options are selected
+// deselects the oldest selected option, instead of ignoring the selection.
+func (p InteractiveMultiselectPrinter) WithReplaceOldestSelection(b ...bool) *InteractiveMultiselectPrinter {
+	p.ReplaceOldestSelection = internal.WithBoolean(b)
+	return &p
+}
+
+// WithFallbackDisabled sets if single key presses should be read, even if stdin is not a terminal.
+// By default, the comma separated numbers of the options are read from a line in that case.
+func (p InteractiveMultiselectPrinter) WithFallbackDisabled(b ...bool) *InteractiveMultiselectPrinter {
+	p.FallbackDisabled = internal.WithBoolean(b)
+	return &p
+}
+
+// WithMouse sets if an option can be selected and deselected by clicking it and the options can be scrolled with the mouse wheel.
+// Mouse reporting is only enabled, while the menu is shown, and the terminal is restored afterwards.
+func (p InteractiveMultiselectPrinter) WithMouse(b ...bool) *InteractiveMultiselectPrinter {
+	p.Mouse = internal.WithBoolean(b)
+	return &p
+}
+
 // WithCheckmark sets the checkmark
 func (p InteractiveMultiselectPrinter) WithCheckmark(checkmark *Checkmark) *InteractiveMultiselectPrinter {
 	p.Checkmark = checkmark
@@ -106,6 +190,26 @@ func (p InteractiveMultiselectPrinter) WithCheckmark(checkmark *Checkmark) *Inte
 
 // Show shows the interactive multiselect menu and returns the selected entry.
 func (p *InteractiveMultiselectPrinter) Show(text ...string) ([]string, error) {
+	return p.ShowWithContext(context.Background(), text...)
+}
+
+// ShowWithIndices shows the interactive multiselect menu and returns the selected entries and their indices in the options.
+// The indices distinguish options with the same text.
+func (p *InteractiveMultiselectPrinter) ShowWithIndices(text ...string) ([]string, []int, error) {
+	result, err := p.ShowWithContext(context.Background(), text...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, append([]int{}, p.selectedOptions...), nil
+}
+
+// ShowWithContext shows the interactive multiselect menu, like Show.
+// If the context is done before the selection was confirmed, the menu stops and the error of the context is returned.
+func (p *InteractiveMultiselectPrinter) ShowWithContext(ctx context.Context, text ...string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// should be the first defer statement to make sure it is executed last
 	// and all the needed cleanup can be done before
 	cancel, exit := internal.NewCancelationSignal()
@@ -117,6 +221,10 @@ func (p *InteractiveMultiselectPrinter) Show(text ...string) ([]string, error) {
 
 	p.text = p.TextStyle.Sprint(text[0])
 	p.fuzzySearchMatches = append([]string{}, p.Options...)
+	p.fuzzySearchIndices = make([]int, len(p.Options))
+	for i := range p.Options {
+		p.fuzzySearchIndices[i] = i
+	}
 
 	if p.MaxHeight == 0 {
 		p.MaxHeight = DefaultInteractiveMultiselect.MaxHeight
@@ -128,15 +236,27 @@ func (p *InteractiveMultiselectPrinter) Show(text ...string) ([]string, error) {
 	}
 
 	if len(p.Options) == 0 {
-		return nil, fmt.Errorf("no options provided")
+		return nil, ErrNoOptions
 	}
 
 	p.displayedOptions = append([]string{}, p.fuzzySearchMatches[:maxHeight]...)
 	p.displayedOptionsStart = 0
 	p.displayedOptionsEnd = maxHeight
 
+	p.selectedOptions = []int{}
 	for _, option := range p.DefaultOptions {
-		p.selectOption(option)
+		if i := p.findUnselectedOption(option); i != -1 {
+			p.selectOption(i)
+		}
+	}
+	for _, i := range p.DefaultSelected {
+		if i >= 0 && i < len(p.Options) && !p.isSelected(i) {
+			p.selectOption(i)
+		}
+	}
+
+	if useLineInput(p.FallbackDisabled) {
+		return p.showLineInput(ctx)
 	}
 
 	area, err := DefaultArea.Start(p.renderSelectMenu())
@@ -151,10 +271,41 @@ func (p *InteractiveMultiselectPrinter) Show(text ...string) ([]string, error) {
 
 	area.Update(p.renderSelectMenu())
 
-	cursor.Hide()
-	defer cursor.Show()
-	err = keyboard.Listen(func(keyInfo keys.Key) (stop bool, err error) {
+	hideCursor()
+	defer showCursor()
+
+	cancelled := atomic.NewBool(false)
+	aborted := atomic.NewBool(false)
+	stopOnDone := stopKeyboardListenerOnDone(ctx, cancelled)
+	defer stopOnDone()
+
+	listen := listenKeyboard
+	if p.Mouse {
+		listen = func(onKeyPress func(key keys.Key) (stop bool, err error)) error {
+			return listenKeyboardAndMouse(onKeyPress, func(event internal.MouseEvent, cursorRow int) (stop bool, err error) {
+				// the first line shows the text
+				i := p.displayedOptionsStart + clickedLine(area.renderedContent(), event.Row, cursorRow) - 1
+				if i < p.displayedOptionsStart || i >= p.displayedOptionsEnd || i >= len(p.fuzzySearchMatches) {
+					return false, nil
+				}
+				p.selectedOption = i
+				p.toggleOption(p.fuzzySearchIndices[i])
+				area.Update(p.renderSelectMenu())
+				return false, nil
+			})
+		}
+	}
+
+	err = listen(func(keyInfo keys.Key) (stop bool, err error) {
+		if cancelled.Load() {
+			aborted.Store(true)
+			return true, nil
+		}
+
 		key := keyInfo.Code
+		if key == keys.Null {
+			return false, nil
+		}
 
 		if p.MaxHeight > len(p.fuzzySearchMatches) {
 			maxHeight = len(p.fuzzySearchMatches)
@@ -167,12 +318,17 @@ func (p *InteractiveMultiselectPrinter) Show(text ...string) ([]string, error) {
 			if len(p.fuzzySearchMatches) == 0 {
 				return false, nil
 			}
+			if p.constraintViolation() != "" {
+				p.showConstraintHint = true
+				area.Update(p.renderSelectMenu())
+				return false, nil
+			}
 			area.Update(p.renderFinishedMenu())
 			return true, nil
 		case p.KeySelect:
 			if len(p.fuzzySearchMatches) > 0 {
 				// Select option if not already selected
-				p.selectOption(p.fuzzySearchMatches[p.selectedOption])
+				p.toggleOption(p.fuzzySearchIndices[p.selectedOption])
 			}
 			area.Update(p.renderSelectMenu())
 		case keys.RuneKey:
@@ -217,16 +373,22 @@ func (p *InteractiveMultiselectPrinter) Show(text ...string) ([]string, error) {
 			p.displayedOptions = append([]string{}, p.fuzzySearchMatches[p.displayedOptionsStart:p.displayedOptionsEnd]...)
 
 			area.Update(p.renderSelectMenu())
-		case keys.Left:
+		case p.KeySelectNone:
 			// Unselect all options
 			p.selectedOptions = []int{}
 			area.Update(p.renderSelectMenu())
-		case keys.Right:
-			// Select all options
-			p.selectedOptions = []int{}
-			for i := 0; i < len(p.Options); i++ {
-				p.selectedOptions = append(p.selectedOptions, i)
+		case p.KeySelectAll:
+			p.selectAll()
+			area.Update(p.renderSelectMenu())
+		case p.KeyInvert:
+			// Select all options, which are not selected and unselect all selected options
+			var inverted []int
+			for i := range p.Options {
+				if !p.isSelected(i) {
+					inverted = append(inverted, i)
+				}
 			}
+			p.selectedOptions = inverted
 			area.Update(p.renderSelectMenu())
 		case keys.Up:
 			if len(p.fuzzySearchMatches) == 0 {
@@ -278,10 +440,17 @@ func (p *InteractiveMultiselectPrinter) Show(text ...string) ([]string, error) {
 
 		return false, nil
 	})
+	if rawModeFailed(err, p.FallbackDisabled) {
+		area.Clear()
+		return p.showLineInput(ctx)
+	}
 	if err != nil {
 		fmt.Println(err)
 		return nil, fmt.Errorf("failed to start keyboard listener: %w", err)
 	}
+	if aborted.Load() {
+		return nil, ctx.Err()
+	}
 
 	var result []string
 	for _, selectedOption := range p.selectedOptions {
@@ -291,18 +460,65 @@ func (p *InteractiveMultiselectPrinter) Show(text ...string) ([]string, error) {
 	return result, nil
 }
 
-func (p InteractiveMultiselectPrinter) findOptionByText(text string) int {
+// showLineInput lists the numbered options and reads the comma separated numbers of the selected options from a line.
+// An empty line keeps the default selection.
+func (p *InteractiveMultiselectPrinter) showLineInput(ctx context.Context) ([]string, error) {
+	content := Sprintf("%s:\n", p.text)
+	var defaultNumbers []string
+	for i, option := range p.Options {
+		checkmark := p.Checkmark.Unchecked
+		if p.isSelected(i) {
+			checkmark = p.Checkmark.Checked
+			defaultNumbers = append(defaultNumbers, strconv.Itoa(i+1))
+		}
+		content += Sprintf("  %d) [%s] %s\n", i+1, checkmark, option)
+	}
+	content += Sprintf("Enter numbers, separated by commas %s: ", p.SelectorStyle.Sprintf("[%s]", strings.Join(defaultNumbers, ",")))
+	Print(content)
+
+	line, err := readLine(ctx)
+	if err != nil {
+		Println()
+		return nil, err
+	}
+
+	if line = strings.TrimSpace(line); line != "" {
+		p.selectedOptions = []int{}
+		for _, field := range strings.Split(line, ",") {
+			number, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil || number < 1 || number > len(p.Options) {
+				return nil, fmt.Errorf("%w: %q is not the number of an option", ErrInvalidInput, strings.TrimSpace(field))
+			}
+			if !p.isSelected(number - 1) {
+				p.selectedOptions = append(p.selectedOptions, number-1)
+			}
+		}
+	}
+	if hint := p.constraintViolation(); hint != "" {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidInput, hint)
+	}
+
+	var result []string
+	for _, selectedOption := range p.selectedOptions {
+		result = append(result, p.Options[selectedOption])
+	}
+	return result, nil
+}
+
+// findUnselectedOption returns the index of the first option with the text, which is not selected yet, or -1.
+func (p InteractiveMultiselectPrinter) findUnselectedOption(text string) int {
 	for i, option := range p.Options {
-		if option == text {
+		if option == text && !p.isSelected(i) {
 			return i
 		}
 	}
 	return -1
 }
 
-func (p *InteractiveMultiselectPrinter) isSelected(optionText string) bool {
+// isSelected returns true, if the option at index i of the Options is selected.
+func (p InteractiveMultiselectPrinter) isSelected(i int) bool {
 	for _, selectedOption := range p.selectedOptions {
-		if p.Options[selectedOption] == optionText {
+		if selectedOption == i {
 			return true
 		}
 	}
@@ -310,21 +526,54 @@ func (p *InteractiveMultiselectPrinter) isSelected(optionText string) bool {
 	return false
 }
 
-func (p *InteractiveMultiselectPrinter) selectOption(optionText string) {
-	if p.isSelected(optionText) {
-		// Remove from selected options
-		for i, selectedOption := range p.selectedOptions {
-			if p.Options[selectedOption] == optionText {
-				p.selectedOptions = append(p.selectedOptions[:i], p.selectedOptions[i+1:]...)
-				break
-			}
+// toggleOption deselects the option at index i of the Options, if it is selected, and selects it otherwise.
+func (p *InteractiveMultiselectPrinter) toggleOption(i int) {
+	for j, selectedOption := range p.selectedOptions {
+		if selectedOption == i {
+			// Remove from selected options
+			p.selectedOptions = append(p.selectedOptions[:j], p.selectedOptions[j+1:]...)
+			return
+		}
+	}
+	p.selectOption(i)
+}
+
+// selectOption selects the option at index i of the Options, respecting MaxSelected.
+func (p *InteractiveMultiselectPrinter) selectOption(i int) {
+	if p.MaxSelected > 0 && len(p.selectedOptions) >= p.MaxSelected {
+		if !p.ReplaceOldestSelection {
+			return
+		}
+		p.selectedOptions = p.selectedOptions[len(p.selectedOptions)-p.MaxSelected+1:]
+	}
+	// Add to selected options
+	p.selectedOptions = append(p.selectedOptions, i)
+}
+
+// selectAll selects all options. If MaxSelected is set, the selected options are kept,
+// and the first unselected options are added, until MaxSelected options are selected.
+func (p *InteractiveMultiselectPrinter) selectAll() {
+	for i := range p.Options {
+		if p.MaxSelected > 0 && len(p.selectedOptions) >= p.MaxSelected {
+			return
+		}
+		if !p.isSelected(i) {
+			p.selectedOptions = append(p.selectedOptions, i)
 		}
-	} else {
-		// Add to selected options
-		p.selectedOptions = append(p.selectedOptions, p.findOptionByText(optionText))
 	}
 }
 
+// constraintViolation returns a hint, if the selected options do not satisfy MinSelected and MaxSelected.
+func (p InteractiveMultiselectPrinter) constraintViolation() string {
+	if p.MinSelected > 0 && len(p.selectedOptions) < p.MinSelected {
+		return fmt.Sprintf("select at least %d options", p.MinSelected)
+	}
+	if p.MaxSelected > 0 && len(p.selectedOptions) > p.MaxSelected {
+		return fmt.Sprintf("select at most %d options", p.MaxSelected)
+	}
+	return ""
+}
+
 func (p *InteractiveMultiselectPrinter) renderSelectMenu() string {
 	var content string
 	content += Sprintf("%s: %s\n", p.text, p.fuzzySearchString)
@@ -333,11 +582,13 @@ func (p *InteractiveMultiselectPrinter) renderSelectMenu() string {
 	rankedResults := fuzzy.RankFindFold(p.fuzzySearchString, p.Options)
 	// map rankedResults to fuzzySearchMatches
 	p.fuzzySearchMatches = []string{}
+	p.fuzzySearchIndices = []int{}
 	if len(rankedResults) != len(p.Options) {
 		sort.Sort(rankedResults)
 	}
 	for _, result := range rankedResults {
 		p.fuzzySearchMatches = append(p.fuzzySearchMatches, result.Target)
+		p.fuzzySearchIndices = append(p.fuzzySearchIndices, result.OriginalIndex)
 	}
 
 	indexMapper := make([]string, len(p.fuzzySearchMatches))
@@ -353,7 +604,7 @@ func (p *InteractiveMultiselectPrinter) renderSelectMenu() string {
 			continue
 		}
 		var checkmark string
-		if p.isSelected(option) {
+		if p.isSelected(p.fuzzySearchIndices[i]) {
 			checkmark = fmt.Sprintf("[%s]", p.Checkmark.Checked)
 		} else {
 			checkmark = fmt.Sprintf("[%s]", p.Checkmark.Unchecked)
@@ -365,12 +616,17 @@ func (p *InteractiveMultiselectPrinter) renderSelectMenu() string {
 		}
 	}
 
-	help := fmt.Sprintf("%s: %s | %s: %s | left: %s | right: %s", p.KeySelect, Bold.Sprint("select"), p.KeyConfirm, Bold.Sprint("confirm"), Bold.Sprint("none"), Bold.Sprint("all"))
+	help := fmt.Sprintf("%s: %s | %s: %s | %s: %s | %s: %s | %s: %s", p.KeySelect, Bold.Sprint("select"), p.KeyConfirm, Bold.Sprint("confirm"),
+		p.KeySelectNone, Bold.Sprint("none"), p.KeySelectAll, Bold.Sprint("all"), p.KeyInvert, Bold.Sprint("invert"))
 	if p.Filter {
 		help += fmt.Sprintf("| type to %s", Bold.Sprint("filter"))
 	}
 	content += ThemeDefault.SecondaryStyle.Sprintfln(help)
 
+	if hint := p.constraintViolation(); p.showConstraintHint && hint != "" {
+		content += ThemeDefault.ErrorMessageStyle.Sprintfln(hint)
+	}
+
 	return content
 }
 
@@ -3,7 +3,9 @@
 package pterm_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
@@ -68,3 +70,215 @@ func TestInteractiveMultiselectPrinter_WithCheckmark(t *testing.T) {
 	p := pterm.DefaultInteractiveMultiselect.WithCheckmark(&pterm.Checkmark{Checked: "+", Unchecked: "-"}).WithOptions([]string{"a", "b", "c"})
 	testza.AssertEqual(t, p.Checkmark, &pterm.Checkmark{Checked: "+", Unchecked: "-"})
 }
+
+func TestInteractiveMultiselectPrinter_Show_Invert(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.CtrlR)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithDefaultOptions([]string{"b"}).Show()
+	testza.AssertEqual(t, []string{"a", "c"}, result)
+}
+
+func TestInteractiveMultiselectPrinter_Show_MinSelectedBlocksConfirm(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Tab)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithMinSelected(1).Show()
+	testza.AssertEqual(t, []string{"a"}, result)
+}
+
+func TestInteractiveMultiselectPrinter_Show_MaxSelectedIgnoresSelection(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithMaxSelected(1).Show()
+	testza.AssertEqual(t, []string{"a"}, result)
+}
+
+func TestInteractiveMultiselectPrinter_Show_MaxSelectedReplacesOldest(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithDefaultOptions([]string{"a", "b"}).
+		WithMaxSelected(2).WithReplaceOldestSelection().Show()
+	testza.AssertEqual(t, []string{"b", "c"}, result)
+}
+
+func TestInteractiveMultiselectPrinter_Show_MaxSelectedBlocksConfirm(t *testing.T) {
+	go func() {
+		// inverting selects b and c
+		keyboard.SimulateKeyPress(keys.CtrlR)
+		keyboard.SimulateKeyPress(keys.Tab)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithDefaultOptions([]string{"a"}).
+		WithMaxSelected(1).Show()
+	testza.AssertEqual(t, []string{"c"}, result)
+}
+
+func TestInteractiveMultiselectPrinter_Show_SelectAllRespectsMaxSelected(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Right)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c", "d"}).WithMaxSelected(2).Show()
+	testza.AssertEqual(t, []string{"c", "a"}, result)
+}
+
+func TestInteractiveMultiselectPrinter_WithKeySelectAll(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithKeySelectAll(keys.CtrlA)
+	testza.AssertEqual(t, p.KeySelectAll, keys.CtrlA)
+}
+
+func TestInteractiveMultiselectPrinter_WithKeySelectNone(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithKeySelectNone(keys.CtrlN)
+	testza.AssertEqual(t, p.KeySelectNone, keys.CtrlN)
+}
+
+func TestInteractiveMultiselectPrinter_WithKeyInvert(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithKeyInvert(keys.CtrlI)
+	testza.AssertEqual(t, p.KeyInvert, keys.CtrlI)
+}
+
+func TestInteractiveMultiselectPrinter_WithMinSelected(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithMinSelected(2)
+	testza.AssertEqual(t, p.MinSelected, 2)
+}
+
+func TestInteractiveMultiselectPrinter_WithMaxSelected(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithMaxSelected(3)
+	testza.AssertEqual(t, p.MaxSelected, 3)
+}
+
+func TestInteractiveMultiselectPrinter_WithReplaceOldestSelection(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithReplaceOldestSelection()
+	testza.AssertTrue(t, p.ReplaceOldestSelection)
+}
+
+func TestInteractiveMultiselectPrinter_ShowWithContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result, err := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b"}).ShowWithContext(ctx)
+	testza.AssertNil(t, result)
+	testza.AssertErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInteractiveMultiselectPrinter_ShowWithContext_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b"}).ShowWithContext(ctx)
+	testza.AssertNil(t, result)
+	testza.AssertErrorIs(t, err, context.Canceled)
+}
+
+func TestInteractiveMultiselectPrinter_WithDefaultSelected(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithDefaultSelected([]int{1, 3})
+	testza.AssertEqual(t, []int{1, 3}, p.DefaultSelected)
+	testza.AssertNil(t, pterm.DefaultInteractiveMultiselect.DefaultSelected)
+}
+
+func TestInteractiveMultiselectPrinter_ShowWithIndices(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, indices, err := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithDefaultSelected([]int{0}).ShowWithIndices()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, []string{"a", "c"}, result)
+	testza.AssertEqual(t, []int{0, 2}, indices)
+}
+
+func TestInteractiveMultiselectPrinter_ShowWithIndices_DuplicateOptions(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, indices, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"x", "y", "x"}).WithDefaultSelected([]int{1}).ShowWithIndices()
+	testza.AssertEqual(t, []string{"y", "x"}, result)
+	testza.AssertEqual(t, []int{1, 2}, indices)
+}
+
+func TestInteractiveMultiselectPrinter_DefaultSelectedCanBeDeselected(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	_, indices, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithDefaultSelected([]int{1, 2, 7, -1}).ShowWithIndices()
+	testza.AssertEqual(t, []int{2}, indices)
+}
+
+func TestInteractiveMultiselectPrinter_DefaultOptionsWithDuplicates(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	_, indices, _ := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"x", "y", "x"}).WithDefaultOptions([]string{"x", "x"}).ShowWithIndices()
+	testza.AssertEqual(t, []int{0, 2}, indices)
+}
+
+func TestInteractiveMultiselectPrinter_WithFallbackDisabled(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithFallbackDisabled()
+	testza.AssertTrue(t, p.FallbackDisabled)
+	testza.AssertFalse(t, pterm.DefaultInteractiveMultiselect.FallbackDisabled)
+}
+
+func TestInteractiveMultiselectPrinter_WithMouse(t *testing.T) {
+	p := pterm.DefaultInteractiveMultiselect.WithMouse()
+	testza.AssertTrue(t, p.Mouse)
+	testza.AssertFalse(t, pterm.DefaultInteractiveMultiselect.Mouse)
+}
+
+func TestInteractiveMultiselectPrinter_WithMouse_KeyboardStillWorks(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, err := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithMouse().Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, []string{"b"}, result)
+}
+
+func TestInteractiveMultiselectPrinter_LineInput(t *testing.T) {
+	simulateLineInput(t, "3, 1,3\n")
+	result, indices, err := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).ShowWithIndices()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, []string{"c", "a"}, result)
+	testza.AssertEqual(t, []int{2, 0}, indices)
+}
+
+func TestInteractiveMultiselectPrinter_LineInputDefault(t *testing.T) {
+	simulateLineInput(t, "\n")
+	result, err := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithDefaultOptions([]string{"b"}).Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, []string{"b"}, result)
+}
+
+func TestInteractiveMultiselectPrinter_LineInputInvalid(t *testing.T) {
+	simulateLineInput(t, "1,x\n")
+	_, err := pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).Show()
+	testza.AssertErrorIs(t, err, pterm.ErrInvalidInput)
+
+	// the constraints have to be satisfied
+	simulateLineInput(t, "1,2\n")
+	_, err = pterm.DefaultInteractiveMultiselect.WithOptions([]string{"a", "b", "c"}).WithMaxSelected(1).Show()
+	testza.AssertErrorIs(t, err, pterm.ErrInvalidInput)
+}
@@ -1,15 +1,18 @@
 package pterm
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
+	"strings"
+	"unicode"
 
-	"atomicgo.dev/cursor"
-	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
 	"github.com/lithammer/fuzzysearch/fuzzy"
 	"github.com/pterm/pterm/internal"
+	"go.uber.org/atomic"
 )
 
 var (
@@ -23,9 +26,25 @@ var (
 		MaxHeight:     5,
 		Selector:      ">",
 		SelectorStyle: &ThemeDefault.SecondaryStyle,
+		Filter:        true,
+		FilterStyle:   &ThemeDefault.HighlightStyle,
+		HeaderStyle:   &ThemeDefault.SecondaryStyle,
+
+		ScrollIndicatorStyle: &ThemeDefault.SecondaryStyle,
+		ShortcutStyle:        &ThemeDefault.SecondaryStyle,
 	}
 )
 
+// SelectOption is an entry of an InteractiveSelectPrinter.
+// Headers are used to group the options below them and can't be selected.
+type SelectOption struct {
+	Text     string
+	IsHeader bool
+	// Shortcut is the key, which jumps to the option. It is shown in front of the option, like "[k] Keep".
+	// If Shortcut is zero, the option has no shortcut.
+	Shortcut rune
+}
+
 // InteractiveSelectPrinter is a printer for interactive select menus.
 type InteractiveSelectPrinter struct {
 	TextStyle     *Style
@@ -36,12 +55,40 @@ type InteractiveSelectPrinter struct {
 	MaxHeight     int
 	Selector      string
 	SelectorStyle *Style
+	// If Filter is true, the options can be filtered by typing.
+	// It is enabled in DefaultInteractiveSelect, but not in an InteractiveSelectPrinter, which is created from scratch.
+	Filter      bool
+	FilterStyle *Style
+	// SelectOptions are used instead of Options, if they are set.
+	SelectOptions []SelectOption
+	HeaderStyle   *Style
+	// RenderItem is used to render the options, if it is set.
+	RenderItem func(option string, index int, selected bool) string
+	// If ShowScrollIndicators is true, "▲" and "▼" are shown above and below the options, if options are hidden in that direction.
+	// The indicators take up two lines more than MaxHeight, so they are disabled by default.
+	ShowScrollIndicators bool
+	ScrollIndicatorStyle *Style
+	// If AutoSelectSingle is true, the only selectable option is returned without waiting for the user.
+	AutoSelectSingle bool
+	// If stdin is not a terminal, the number of an option is read from a line instead.
+	// If FallbackDisabled is true, single key presses are read anyway.
+	FallbackDisabled bool
+	ShortcutStyle    *Style
+	// If SelectOnShortcut is true, pressing the shortcut of an option selects it immediately.
+	// Otherwise, the first press jumps to the option and the second one selects it.
+	SelectOnShortcut bool
+	// If Mouse is true, an option can be selected by clicking it and the options can be scrolled with the mouse wheel,
+	// if the terminal supports SGR mouse reporting. The keyboard can be used at the same time.
+	Mouse bool
 
 	selectedOption        int
 	result                string
+	resultIndex           int
 	text                  string
 	fuzzySearchString     string
 	fuzzySearchMatches    []string
+	fuzzySearchIndices    []int
+	fuzzySearchHeaders    []bool
 	displayedOptions      []string
 	displayedOptionsStart int
 	displayedOptionsEnd   int
@@ -59,6 +106,20 @@ func (p InteractiveSelectPrinter) WithOptions(options []string) *InteractiveSele
 	return &p
 }
 
+// WithSelectOptions sets the options, which can contain headers.
+// Headers are rendered with the HeaderStyle, are skipped while navigating and can't be selected.
+// When filtering, a header is shown as long as one of its options matches.
+func (p InteractiveSelectPrinter) WithSelectOptions(options []SelectOption) *InteractiveSelectPrinter {
+	p.SelectOptions = options
+	return &p
+}
+
+// WithHeaderStyle sets the style of the headers in SelectOptions.
+func (p InteractiveSelectPrinter) WithHeaderStyle(style *Style) *InteractiveSelectPrinter {
+	p.HeaderStyle = style
+	return &p
+}
+
 // WithDefaultOption sets the default options.
 func (p InteractiveSelectPrinter) WithDefaultOption(option string) *InteractiveSelectPrinter {
 	p.DefaultOption = option
@@ -71,8 +132,94 @@ func (p InteractiveSelectPrinter) WithMaxHeight(maxHeight int) *InteractiveSelec
 	return &p
 }
 
+// WithShowScrollIndicators sets if "▲" and "▼" are shown above and below the options, if options are hidden in that direction.
+func (p InteractiveSelectPrinter) WithShowScrollIndicators(b ...bool) *InteractiveSelectPrinter {
+	p.ShowScrollIndicators = internal.WithBoolean(b)
+	return &p
+}
+
+// WithScrollIndicatorStyle sets the style of the scroll indicators.
+func (p InteractiveSelectPrinter) WithScrollIndicatorStyle(style *Style) *InteractiveSelectPrinter {
+	p.ScrollIndicatorStyle = style
+	return &p
+}
+
+// WithAutoSelectSingle sets if the only selectable option should be returned without waiting for the user to confirm it.
+func (p InteractiveSelectPrinter) WithAutoSelectSingle(b ...bool) *InteractiveSelectPrinter {
+	p.AutoSelectSingle = internal.WithBoolean(b)
+	return &p
+}
+
+// WithFallbackDisabled sets if single key presses should be read, even if stdin is not a terminal.
+// By default, the number of an option is read from a line in that case.
+func (p InteractiveSelectPrinter) WithFallbackDisabled(b ...bool) *InteractiveSelectPrinter {
+	p.FallbackDisabled = internal.WithBoolean(b)
+	return &p
+}
+
+// WithShortcutStyle sets the style of the shortcuts of the SelectOptions.
+func (p InteractiveSelectPrinter) WithShortcutStyle(style *Style) *InteractiveSelectPrinter {
+	p.ShortcutStyle = style
+	return &p
+}
+
+// WithSelectOnShortcut sets if pressing the shortcut of an option selects it immediately, instead of jumping to it.
+func (p InteractiveSelectPrinter) WithSelectOnShortcut(b ...bool) *InteractiveSelectPrinter {
+	p.SelectOnShortcut = internal.WithBoolean(b)
+	return &p
+}
+
+// WithMouse sets if an option can be selected by clicking it and the options can be scrolled with the mouse wheel.
+// Mouse reporting is only enabled, while the menu is shown, and the terminal is restored afterwards.
+func (p InteractiveSelectPrinter) WithMouse(b ...bool) *InteractiveSelectPrinter {
+	p.Mouse = internal.WithBoolean(b)
+	return &p
+}
+
+// WithFilter sets if the options can be filtered by typing.
+// Filtering is enabled in DefaultInteractiveSelect.
+func (p InteractiveSelectPrinter) WithFilter(b ...bool) *InteractiveSelectPrinter {
+	p.Filter = internal.WithBoolean(b)
+	return &p
+}
+
+// WithFilterStyle sets the style, which is used to highlight the characters matching the filter.
+func (p InteractiveSelectPrinter) WithFilterStyle(style *Style) *InteractiveSelectPrinter {
+	p.FilterStyle = style
+	return &p
+}
+
+// WithRenderItem sets a function, which renders a single option of the select menu.
+// The function gets the option, its index in the options and if it is currently selected, and returns the whole row, including the selector.
+// Rows are cut at the first line break and truncated to the terminal width, so that scrolling keeps working.
+func (p InteractiveSelectPrinter) WithRenderItem(renderItem func(option string, index int, selected bool) string) *InteractiveSelectPrinter {
+	p.RenderItem = renderItem
+	return &p
+}
+
 // Show shows the interactive select menu and returns the selected entry.
 func (p *InteractiveSelectPrinter) Show(text ...string) (string, error) {
+	return p.ShowWithContext(context.Background(), text...)
+}
+
+// ShowWithIndex shows the interactive select menu and returns the selected entry and its index in the options.
+// If SelectOptions are used, the index includes the headers.
+// The index is -1 if no option was selected.
+func (p *InteractiveSelectPrinter) ShowWithIndex(text ...string) (string, int, error) {
+	result, err := p.ShowWithContext(context.Background(), text...)
+	if err != nil {
+		return "", -1, err
+	}
+	return result, p.resultIndex, nil
+}
+
+// ShowWithContext shows the interactive select menu, like Show.
+// If the context is done before an option was confirmed, the menu stops and the error of the context is returned.
+func (p *InteractiveSelectPrinter) ShowWithContext(ctx context.Context, text ...string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
 	// should be the first defer statement to make sure it is executed last
 	// and all the needed cleanup can be done before
 	cancel, exit := internal.NewCancelationSignal()
@@ -82,8 +229,21 @@ func (p *InteractiveSelectPrinter) Show(text ...string) (string, error) {
 		text = []string{p.DefaultText}
 	}
 
+	p.resultIndex = -1
 	p.text = p.TextStyle.Sprint(text[0])
-	p.fuzzySearchMatches = append([]string{}, p.Options...)
+	if p.HeaderStyle == nil {
+		p.HeaderStyle = NewStyle()
+	}
+	if p.ScrollIndicatorStyle == nil {
+		p.ScrollIndicatorStyle = NewStyle()
+	}
+	if p.ShortcutStyle == nil {
+		p.ShortcutStyle = NewStyle()
+	}
+	if err := p.checkShortcuts(); err != nil {
+		return "", err
+	}
+	p.filterOptions()
 
 	if p.MaxHeight == 0 {
 		p.MaxHeight = DefaultInteractiveSelect.MaxHeight
@@ -94,32 +254,44 @@ func (p *InteractiveSelectPrinter) Show(text ...string) (string, error) {
 		maxHeight = len(p.fuzzySearchMatches)
 	}
 
-	if len(p.Options) == 0 {
-		return "", fmt.Errorf("no options provided")
+	if p.firstSelectableOption() == -1 {
+		return "", ErrNoOptions
 	}
 
 	p.displayedOptions = append([]string{}, p.fuzzySearchMatches[:maxHeight]...)
 	p.displayedOptionsStart = 0
 	p.displayedOptionsEnd = maxHeight
+	p.selectedOption = p.firstSelectableOption()
 
 	// Get index of default option
 	if p.DefaultOption != "" {
-		for i, option := range p.Options {
-			if option == p.DefaultOption {
+		for i, option := range p.fuzzySearchMatches {
+			if option == p.DefaultOption && !p.isHeader(i) {
 				p.selectedOption = i
-				if i > 0 && len(p.Options) > maxHeight {
-					p.displayedOptionsEnd = int(math.Min(float64(i-1+maxHeight), float64(len(p.Options))))
+				if i > 0 && len(p.fuzzySearchMatches) > maxHeight {
+					p.displayedOptionsEnd = int(math.Min(float64(i-1+maxHeight), float64(len(p.fuzzySearchMatches))))
 					p.displayedOptionsStart = p.displayedOptionsEnd - maxHeight
 				} else {
 					p.displayedOptionsStart = 0
 					p.displayedOptionsEnd = maxHeight
 				}
-				p.displayedOptions = p.Options[p.displayedOptionsStart:p.displayedOptionsEnd]
+				p.displayedOptions = p.fuzzySearchMatches[p.displayedOptionsStart:p.displayedOptionsEnd]
 				break
 			}
 		}
 	}
 
+	if p.AutoSelectSingle && p.selectableOptionCount() == 1 {
+		p.result = p.fuzzySearchMatches[p.selectedOption]
+		p.resultIndex = p.fuzzySearchIndices[p.selectedOption]
+		Print(p.renderFinishedMenu())
+		return p.result, nil
+	}
+
+	if useLineInput(p.FallbackDisabled) {
+		return p.showLineInput(ctx)
+	}
+
 	area, err := DefaultArea.Start(p.renderSelectMenu())
 	defer area.Stop()
 	if err != nil {
@@ -128,10 +300,37 @@ func (p *InteractiveSelectPrinter) Show(text ...string) (string, error) {
 
 	area.Update(p.renderSelectMenu())
 
-	cursor.Hide()
-	defer cursor.Show()
+	hideCursor()
+	defer showCursor()
+
+	cancelled := atomic.NewBool(false)
+	aborted := atomic.NewBool(false)
+	stopOnDone := stopKeyboardListenerOnDone(ctx, cancelled)
+	defer stopOnDone()
+
+	listen := listenKeyboard
+	if p.Mouse {
+		listen = func(onKeyPress func(key keys.Key) (stop bool, err error)) error {
+			return listenKeyboardAndMouse(onKeyPress, func(event internal.MouseEvent, cursorRow int) (stop bool, err error) {
+				i := p.optionAtLine(clickedLine(area.renderedContent(), event.Row, cursorRow))
+				if i == -1 || p.isHeader(i) {
+					return false, nil
+				}
+				p.selectedOption = i
+				p.result = p.fuzzySearchMatches[i]
+				p.resultIndex = p.fuzzySearchIndices[i]
+				area.Update(p.renderFinishedMenu())
+				return true, nil
+			})
+		}
+	}
+
+	err = listen(func(keyInfo keys.Key) (stop bool, err error) {
+		if cancelled.Load() {
+			aborted.Store(true)
+			return true, nil
+		}
 
-	err = keyboard.Listen(func(keyInfo keys.Key) (stop bool, err error) {
 		key := keyInfo.Code
 
 		if p.MaxHeight > len(p.fuzzySearchMatches) {
@@ -142,91 +341,74 @@ func (p *InteractiveSelectPrinter) Show(text ...string) (string, error) {
 
 		switch key {
 		case keys.RuneKey:
+			if i := p.shortcutOption(keyInfo); i != -1 {
+				if p.SelectOnShortcut || i == p.selectedOption {
+					p.selectedOption = i
+					p.result = p.fuzzySearchMatches[i]
+					p.resultIndex = p.fuzzySearchIndices[i]
+					area.Update(p.renderFinishedMenu())
+					return true, nil
+				}
+				p.selectedOption = i
+				p.scrollToSelection(maxHeight)
+				area.Update(p.renderSelectMenu())
+				return false, nil
+			}
+			if !p.Filter {
+				return false, nil
+			}
 			// Fuzzy search for options
 			// append to fuzzy search string
 			p.fuzzySearchString += keyInfo.String()
-			p.selectedOption = 0
-			p.displayedOptionsStart = 0
-			p.displayedOptionsEnd = maxHeight
-			p.displayedOptions = append([]string{}, p.fuzzySearchMatches[:maxHeight]...)
+			p.resetDisplayedOptions()
 			area.Update(p.renderSelectMenu())
 		case keys.Space:
+			if !p.Filter {
+				return false, nil
+			}
 			p.fuzzySearchString += " "
-			p.selectedOption = 0
+			p.resetDisplayedOptions()
 			area.Update(p.renderSelectMenu())
 		case keys.Backspace:
+			if !p.Filter {
+				return false, nil
+			}
 			// Remove last character from fuzzy search string
 			if len(p.fuzzySearchString) > 0 {
 				// Handle UTF-8 characters
 				p.fuzzySearchString = string([]rune(p.fuzzySearchString)[:len([]rune(p.fuzzySearchString))-1])
 			}
-
-			if p.fuzzySearchString == "" {
-				p.fuzzySearchMatches = append([]string{}, p.Options...)
-			}
-
-			p.renderSelectMenu()
-
-			if len(p.fuzzySearchMatches) > p.MaxHeight {
-				maxHeight = p.MaxHeight
-			} else {
-				maxHeight = len(p.fuzzySearchMatches)
-			}
-
-			p.selectedOption = 0
-			p.displayedOptionsStart = 0
-			p.displayedOptionsEnd = maxHeight
-			p.displayedOptions = append([]string{}, p.fuzzySearchMatches[p.displayedOptionsStart:p.displayedOptionsEnd]...)
-
+			p.resetDisplayedOptions()
 			area.Update(p.renderSelectMenu())
 		case keys.Up:
 			if len(p.fuzzySearchMatches) == 0 {
 				return false, nil
 			}
-			if p.selectedOption > 0 {
-				p.selectedOption--
-				if p.selectedOption < p.displayedOptionsStart {
-					p.displayedOptionsStart--
-					p.displayedOptionsEnd--
-					if p.displayedOptionsStart < 0 {
-						p.displayedOptionsStart = 0
-						p.displayedOptionsEnd = maxHeight
-					}
-					p.displayedOptions = append([]string{}, p.fuzzySearchMatches[p.displayedOptionsStart:p.displayedOptionsEnd]...)
-				}
-			} else {
-				p.selectedOption = len(p.fuzzySearchMatches) - 1
-				p.displayedOptionsStart = len(p.fuzzySearchMatches) - maxHeight
-				p.displayedOptionsEnd = len(p.fuzzySearchMatches)
-				p.displayedOptions = append([]string{}, p.fuzzySearchMatches[p.displayedOptionsStart:p.displayedOptionsEnd]...)
-			}
-
+			p.moveSelection(-1, maxHeight)
 			area.Update(p.renderSelectMenu())
 		case keys.Down:
 			if len(p.fuzzySearchMatches) == 0 {
 				return false, nil
 			}
-			p.displayedOptions = p.fuzzySearchMatches[:maxHeight]
-			if p.selectedOption < len(p.fuzzySearchMatches)-1 {
-				p.selectedOption++
-				if p.selectedOption >= p.displayedOptionsEnd {
-					p.displayedOptionsStart++
-					p.displayedOptionsEnd++
-					p.displayedOptions = append([]string{}, p.fuzzySearchMatches[p.displayedOptionsStart:p.displayedOptionsEnd]...)
-				}
-			} else {
-				p.selectedOption = 0
-				p.displayedOptionsStart = 0
-				p.displayedOptionsEnd = maxHeight
-				p.displayedOptions = append([]string{}, p.fuzzySearchMatches[p.displayedOptionsStart:p.displayedOptionsEnd]...)
+			p.moveSelection(1, maxHeight)
+			area.Update(p.renderSelectMenu())
+		case keys.PgUp:
+			if len(p.fuzzySearchMatches) == 0 {
+				return false, nil
 			}
-
+			p.moveSelectionByPage(-1, maxHeight)
+			area.Update(p.renderSelectMenu())
+		case keys.PgDown:
+			if len(p.fuzzySearchMatches) == 0 {
+				return false, nil
+			}
+			p.moveSelectionByPage(1, maxHeight)
 			area.Update(p.renderSelectMenu())
 		case keys.CtrlC:
 			cancel()
 			return true, nil
 		case keys.Enter:
-			if len(p.fuzzySearchMatches) == 0 {
+			if p.firstSelectableOption() == -1 {
 				return false, nil
 			}
 			area.Update(p.renderFinishedMenu())
@@ -235,33 +417,80 @@ func (p *InteractiveSelectPrinter) Show(text ...string) (string, error) {
 
 		return false, nil
 	})
+	if rawModeFailed(err, p.FallbackDisabled) {
+		area.Clear()
+		return p.showLineInput(ctx)
+	}
 	if err != nil {
 		fmt.Println(err)
 		return "", fmt.Errorf("failed to start keyboard listener: %w", err)
 	}
+	if aborted.Load() {
+		return "", ctx.Err()
+	}
 
 	return p.result, nil
 }
 
-func (p *InteractiveSelectPrinter) renderSelectMenu() string {
-	var content string
-	content += Sprintf("%s %s: %s\n", p.text, p.SelectorStyle.Sprint("[type to search]"), p.fuzzySearchString)
+// showLineInput lists the numbered options and reads the number of the selected option from a line.
+// An empty line selects the default option.
+func (p *InteractiveSelectPrinter) showLineInput(ctx context.Context) (string, error) {
+	content := Sprintf("%s:\n", p.text)
+	var selectable []int
+	var defaultNumber int
+	for i, option := range p.fuzzySearchMatches {
+		if p.isHeader(i) {
+			content += Sprintf("%s\n", p.HeaderStyle.Sprint(option))
+			continue
+		}
+		selectable = append(selectable, i)
+		if i == p.selectedOption {
+			defaultNumber = len(selectable)
+		}
+		content += Sprintf("  %d) %s%s\n", len(selectable), p.renderShortcut(i), p.OptionStyle.Sprint(option))
+	}
+	content += Sprintf("Enter a number %s: ", p.SelectorStyle.Sprintf("[%d]", defaultNumber))
+	Print(content)
 
-	// find options that match fuzzy search string
-	rankedResults := fuzzy.RankFindFold(p.fuzzySearchString, p.Options)
-	// map rankedResults to fuzzySearchMatches
-	p.fuzzySearchMatches = []string{}
-	if len(rankedResults) != len(p.Options) {
-		sort.Sort(rankedResults)
+	line, err := readLine(ctx)
+	if err != nil {
+		Println()
+		return "", err
 	}
-	for _, result := range rankedResults {
-		p.fuzzySearchMatches = append(p.fuzzySearchMatches, result.Target)
+
+	if line = strings.TrimSpace(line); line != "" {
+		if i := p.findShortcut([]rune(line)); i != -1 {
+			p.selectedOption = i
+		} else if number, err := strconv.Atoi(line); err == nil && number >= 1 && number <= len(selectable) {
+			p.selectedOption = selectable[number-1]
+		} else {
+			return "", fmt.Errorf("%w: %q is not the number of an option", ErrInvalidInput, line)
+		}
 	}
 
-	if len(p.fuzzySearchMatches) != 0 {
-		p.result = p.fuzzySearchMatches[p.selectedOption]
+	p.result = p.fuzzySearchMatches[p.selectedOption]
+	p.resultIndex = p.fuzzySearchIndices[p.selectedOption]
+	return p.result, nil
+}
+
+func (p *InteractiveSelectPrinter) renderSelectMenu() string {
+	var content string
+	if p.Filter {
+		content += Sprintf("%s %s: %s\n", p.text, p.SelectorStyle.Sprint("[type to search]"), p.fuzzySearchString)
+	} else {
+		content += Sprintf("%s:\n", p.text)
+	}
+
+	p.filterOptions()
+
+	if p.firstSelectableOption() == -1 {
+		content += Sprintf("  %s\n", ThemeDefault.SecondaryStyle.Sprint("no results"))
+		return content
 	}
 
+	p.result = p.fuzzySearchMatches[p.selectedOption]
+	p.resultIndex = p.fuzzySearchIndices[p.selectedOption]
+
 	indexMapper := make([]string, len(p.fuzzySearchMatches))
 	for i := 0; i < len(p.fuzzySearchMatches); i++ {
 		// if in displayed options range
@@ -270,20 +499,300 @@ func (p *InteractiveSelectPrinter) renderSelectMenu() string {
 		}
 	}
 
+	scrollable := p.scrollable()
+	if scrollable {
+		content += p.renderScrollIndicator("▲", p.displayedOptionsStart > 0)
+	}
+
 	for i, option := range indexMapper {
 		if option == "" {
 			continue
 		}
-		if i == p.selectedOption {
-			content += Sprintf("%s %s\n", p.renderSelector(), p.OptionStyle.Sprint(option))
+		if p.isHeader(i) {
+			content += Sprintf("%s\n", p.HeaderStyle.Sprint(p.highlightFilterMatches(option)))
+		} else if p.RenderItem != nil {
+			content += p.renderItem(i) + "\n"
+		} else if i == p.selectedOption {
+			content += Sprintf("%s %s%s\n", p.renderSelector(), p.renderShortcut(i), p.OptionStyle.Sprint(p.highlightFilterMatches(option)))
 		} else {
-			content += Sprintf("  %s\n", p.OptionStyle.Sprint(option))
+			content += Sprintf("  %s%s\n", p.renderShortcut(i), p.OptionStyle.Sprint(p.highlightFilterMatches(option)))
 		}
 	}
 
+	if scrollable {
+		content += p.renderScrollIndicator("▼", p.displayedOptionsEnd < len(p.fuzzySearchMatches))
+	}
+
 	return content
 }
 
+// scrollable returns true, if the scroll indicators are shown.
+// The indicator lines are always shown, if the options don't fit, so that the height of the menu does not change while scrolling.
+func (p InteractiveSelectPrinter) scrollable() bool {
+	return p.ShowScrollIndicators && p.displayedOptionsEnd-p.displayedOptionsStart < len(p.fuzzySearchMatches)
+}
+
+// optionAtLine returns the index of the fuzzy search match, which is shown in the given line of the select menu, or -1.
+func (p InteractiveSelectPrinter) optionAtLine(line int) int {
+	// the first line shows the text
+	i := line - 1
+	if p.scrollable() {
+		i--
+	}
+	if i < 0 || p.displayedOptionsStart+i >= p.displayedOptionsEnd || p.firstSelectableOption() == -1 {
+		return -1
+	}
+	return p.displayedOptionsStart + i
+}
+
+// renderScrollIndicator returns the line of a scroll indicator, which is empty, if no options are hidden in its direction.
+func (p InteractiveSelectPrinter) renderScrollIndicator(indicator string, hidden bool) string {
+	if !hidden {
+		return "\n"
+	}
+	return Sprintf("  %s\n", p.ScrollIndicatorStyle.Sprint(indicator))
+}
+
+// renderItem renders the fuzzy search match at index i with the RenderItem function.
+// The row is kept on a single line, so that the height of the menu does not change.
+func (p InteractiveSelectPrinter) renderItem(i int) string {
+	row := p.RenderItem(p.fuzzySearchMatches[i], p.fuzzySearchIndices[i], i == p.selectedOption)
+	row, _, _ = strings.Cut(row, "\n")
+	return internal.TruncateString(row, GetTerminalWidth()-1, "…")
+}
+
+// checkShortcuts returns ErrDuplicateShortcut, if multiple SelectOptions have the same shortcut.
+// Shortcuts are case-insensitive.
+func (p InteractiveSelectPrinter) checkShortcuts() error {
+	used := map[rune]string{}
+	for _, option := range p.SelectOptions {
+		if option.IsHeader || option.Shortcut == 0 {
+			continue
+		}
+		r := unicode.ToLower(option.Shortcut)
+		if text, ok := used[r]; ok {
+			return fmt.Errorf("%w: %q is used by %q and %q", ErrDuplicateShortcut, option.Shortcut, text, option.Text)
+		}
+		used[r] = option.Text
+	}
+	return nil
+}
+
+// shortcut returns the shortcut of the fuzzy search match at index i, or zero.
+func (p InteractiveSelectPrinter) shortcut(i int) rune {
+	if len(p.SelectOptions) == 0 || p.isHeader(i) {
+		return 0
+	}
+	return p.SelectOptions[p.fuzzySearchIndices[i]].Shortcut
+}
+
+// renderShortcut returns the shortcut of the fuzzy search match at index i, like "[k] ", or an empty string.
+func (p InteractiveSelectPrinter) renderShortcut(i int) string {
+	if r := p.shortcut(i); r != 0 {
+		return p.ShortcutStyle.Sprintf("[%c]", r) + " "
+	}
+	return ""
+}
+
+// shortcutOption returns the index of the fuzzy search match, whose shortcut was pressed, or -1.
+// If the options can be filtered, typed keys filter the options, so alt has to be pressed together with the shortcut.
+func (p InteractiveSelectPrinter) shortcutOption(key keys.Key) int {
+	if p.Filter && !key.AltPressed {
+		return -1
+	}
+	return p.findShortcut(key.Runes)
+}
+
+// findShortcut returns the index of the fuzzy search match with the given shortcut, or -1.
+func (p InteractiveSelectPrinter) findShortcut(runes []rune) int {
+	if len(runes) != 1 {
+		return -1
+	}
+	for i := range p.fuzzySearchMatches {
+		if r := p.shortcut(i); r != 0 && unicode.ToLower(r) == unicode.ToLower(runes[0]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// filterOptions updates the fuzzy search matches with the options matching the current search string.
+func (p *InteractiveSelectPrinter) filterOptions() {
+	if len(p.SelectOptions) > 0 {
+		p.filterSelectOptions()
+		return
+	}
+	p.fuzzySearchHeaders = nil
+
+	// find options that match fuzzy search string
+	rankedResults := fuzzy.RankFindFold(p.fuzzySearchString, p.Options)
+	// map rankedResults to fuzzySearchMatches
+	p.fuzzySearchMatches = []string{}
+	p.fuzzySearchIndices = []int{}
+	if len(rankedResults) != len(p.Options) {
+		sort.Sort(rankedResults)
+	}
+	for _, result := range rankedResults {
+		p.fuzzySearchMatches = append(p.fuzzySearchMatches, result.Target)
+		p.fuzzySearchIndices = append(p.fuzzySearchIndices, result.OriginalIndex)
+	}
+}
+
+// filterSelectOptions updates the fuzzy search matches with the SelectOptions matching the current search string.
+// The matches keep the order of the SelectOptions, so that every option stays below its header.
+func (p *InteractiveSelectPrinter) filterSelectOptions() {
+	p.fuzzySearchMatches = []string{}
+	p.fuzzySearchIndices = []int{}
+	p.fuzzySearchHeaders = []bool{}
+	header := -1
+	for i, option := range p.SelectOptions {
+		if option.IsHeader {
+			header = i
+			continue
+		}
+		if !fuzzy.MatchFold(p.fuzzySearchString, option.Text) {
+			continue
+		}
+		if header != -1 {
+			p.fuzzySearchMatches = append(p.fuzzySearchMatches, p.SelectOptions[header].Text)
+			p.fuzzySearchIndices = append(p.fuzzySearchIndices, header)
+			p.fuzzySearchHeaders = append(p.fuzzySearchHeaders, true)
+			header = -1
+		}
+		p.fuzzySearchMatches = append(p.fuzzySearchMatches, option.Text)
+		p.fuzzySearchIndices = append(p.fuzzySearchIndices, i)
+		p.fuzzySearchHeaders = append(p.fuzzySearchHeaders, false)
+	}
+}
+
+// isHeader returns true if the fuzzy search match at index i is a header.
+func (p InteractiveSelectPrinter) isHeader(i int) bool {
+	return i < len(p.fuzzySearchHeaders) && p.fuzzySearchHeaders[i]
+}
+
+// firstSelectableOption returns the index of the first fuzzy search match, which is not a header, or -1.
+func (p InteractiveSelectPrinter) firstSelectableOption() int {
+	for i := range p.fuzzySearchMatches {
+		if !p.isHeader(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// selectableOptionCount returns the number of fuzzy search matches, which are not headers.
+func (p InteractiveSelectPrinter) selectableOptionCount() int {
+	var count int
+	for i := range p.fuzzySearchMatches {
+		if !p.isHeader(i) {
+			count++
+		}
+	}
+	return count
+}
+
+// moveSelection moves the selection by one option in the given direction, skipping headers and wrapping around at the ends.
+func (p *InteractiveSelectPrinter) moveSelection(direction int, maxHeight int) {
+	count := len(p.fuzzySearchMatches)
+	for {
+		p.selectedOption = (p.selectedOption + direction + count) % count
+		if !p.isHeader(p.selectedOption) {
+			break
+		}
+	}
+	p.scrollToSelection(maxHeight)
+}
+
+// moveSelectionByPage moves the selection by maxHeight options in the given direction, skipping headers.
+// Unlike moveSelection, it stops at the first and the last option.
+func (p *InteractiveSelectPrinter) moveSelectionByPage(direction int, maxHeight int) {
+	count := len(p.fuzzySearchMatches)
+	selected := p.selectedOption + direction*maxHeight
+	if selected < 0 {
+		selected = 0
+	}
+	if selected >= count {
+		selected = count - 1
+	}
+	// headers are skipped in the direction of the page, or in the opposite direction at the ends
+	step := direction
+	for p.isHeader(selected) {
+		if selected+step < 0 || selected+step >= count {
+			step = -step
+		}
+		selected += step
+	}
+	p.selectedOption = selected
+	p.scrollToSelection(maxHeight)
+}
+
+// scrollToSelection scrolls the displayed options, so that the selected option is visible.
+func (p *InteractiveSelectPrinter) scrollToSelection(maxHeight int) {
+	switch {
+	case p.selectedOption < p.displayedOptionsStart:
+		p.displayedOptionsStart = p.selectedOption
+		// show the header of the first option, if there is room for both
+		if maxHeight > 1 && p.selectedOption > 0 && p.isHeader(p.selectedOption-1) {
+			p.displayedOptionsStart--
+		}
+		p.displayedOptionsEnd = p.displayedOptionsStart + maxHeight
+	case p.selectedOption >= p.displayedOptionsEnd:
+		p.displayedOptionsEnd = p.selectedOption + 1
+		p.displayedOptionsStart = p.displayedOptionsEnd - maxHeight
+	}
+	p.displayedOptions = append([]string{}, p.fuzzySearchMatches[p.displayedOptionsStart:p.displayedOptionsEnd]...)
+}
+
+// resetDisplayedOptions filters the options and scrolls back to the first match.
+func (p *InteractiveSelectPrinter) resetDisplayedOptions() {
+	p.filterOptions()
+
+	maxHeight := p.MaxHeight
+	if maxHeight > len(p.fuzzySearchMatches) {
+		maxHeight = len(p.fuzzySearchMatches)
+	}
+
+	p.selectedOption = 0
+	if first := p.firstSelectableOption(); first != -1 {
+		p.selectedOption = first
+	}
+	p.displayedOptionsStart = 0
+	p.displayedOptionsEnd = maxHeight
+	p.displayedOptions = append([]string{}, p.fuzzySearchMatches[:maxHeight]...)
+}
+
+// highlightFilterMatches highlights the characters of an option, which match the current search string.
+func (p InteractiveSelectPrinter) highlightFilterMatches(option string) string {
+	if p.fuzzySearchString == "" || p.FilterStyle == nil {
+		return option
+	}
+
+	search := []rune(strings.ToLower(p.fuzzySearchString))
+	var ret, run string
+	var runMatches bool
+	var searchIndex int
+	for _, r := range option {
+		matches := searchIndex < len(search) && unicode.ToLower(r) == search[searchIndex]
+		if matches {
+			searchIndex++
+		}
+		if matches != runMatches && run != "" {
+			if runMatches {
+				run = p.FilterStyle.Sprint(run)
+			}
+			ret += run
+			run = ""
+		}
+		runMatches = matches
+		run += string(r)
+	}
+	if runMatches {
+		run = p.FilterStyle.Sprint(run)
+	}
+
+	return ret + run
+}
+
 func (p InteractiveSelectPrinter) renderFinishedMenu() string {
 	var content string
 	content += Sprintf("%s: %s\n", p.text, p.fuzzySearchString)
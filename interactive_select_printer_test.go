@@ -3,8 +3,13 @@
 package pterm_test
 
 import (
+	"context"
+	"io"
+	"os"
 	"testing"
+	"time"
 
+	"atomicgo.dev/cursor"
 	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
 	"github.com/MarvinJWendt/testza"
@@ -32,6 +37,109 @@ func TestInteractiveSelectPrinter_Show_MaxHeightSlidingWindow(t *testing.T) {
 	testza.AssertEqual(t, "c", result)
 }
 
+func TestInteractiveSelectPrinter_Show_Filter(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress("ch")
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithOptions([]string{"apple", "banana", "cherry", "date"}).Show()
+	testza.AssertEqual(t, "cherry", result)
+}
+
+func TestInteractiveSelectPrinter_Show_FilterNavigatesFilteredOptions(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress("an")
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithOptions([]string{"mango", "cherry", "banana"}).Show()
+	testza.AssertEqual(t, "banana", result)
+}
+
+func TestInteractiveSelectPrinter_Show_FilterWithoutResults(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress("xyz")
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Backspace)
+		keyboard.SimulateKeyPress(keys.Backspace)
+		keyboard.SimulateKeyPress(keys.Backspace)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "c"}).Show()
+	testza.AssertEqual(t, "a", result)
+}
+
+func TestInteractiveSelectPrinter_Show_WithoutFilter(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress("c")
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "c"}).WithFilter(false).Show()
+	testza.AssertEqual(t, "a", result)
+}
+
+var groupedSelectOptions = []pterm.SelectOption{
+	{Text: "cluster-a", IsHeader: true},
+	{Text: "default"},
+	{Text: "kube-system"},
+	{Text: "cluster-b", IsHeader: true},
+	{Text: "monitoring"},
+	{Text: "ingress"},
+}
+
+func TestInteractiveSelectPrinter_Show_SelectOptionsSkipHeaders(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithSelectOptions(groupedSelectOptions).Show()
+	testza.AssertEqual(t, "monitoring", result)
+}
+
+func TestInteractiveSelectPrinter_Show_SelectOptionsWrapAround(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Up)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithSelectOptions(groupedSelectOptions).Show()
+	testza.AssertEqual(t, "ingress", result)
+}
+
+func TestInteractiveSelectPrinter_Show_SelectOptionsFilter(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress("ing")
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithSelectOptions(groupedSelectOptions).Show()
+	testza.AssertEqual(t, "monitoring", result)
+}
+
+func TestInteractiveSelectPrinter_Show_SelectOptionsDefaultOption(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Up)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithSelectOptions(groupedSelectOptions).WithDefaultOption("monitoring").Show()
+	testza.AssertEqual(t, "kube-system", result)
+}
+
+func TestInteractiveSelectPrinter_Show_OnlyHeaders(t *testing.T) {
+	_, err := pterm.DefaultInteractiveSelect.WithSelectOptions([]pterm.SelectOption{{Text: "header", IsHeader: true}}).Show()
+	testza.AssertNotNil(t, err)
+}
+
+func TestInteractiveSelectPrinter_WithSelectOptions(t *testing.T) {
+	p := pterm.DefaultInteractiveSelect.WithSelectOptions(groupedSelectOptions)
+	testza.AssertEqual(t, groupedSelectOptions, p.SelectOptions)
+}
+
+func TestInteractiveSelectPrinter_WithHeaderStyle(t *testing.T) {
+	style := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultInteractiveSelect.WithHeaderStyle(style)
+	testza.AssertEqual(t, style, p.HeaderStyle)
+}
+
 func TestInteractiveSelectPrinter_WithDefaultText(t *testing.T) {
 	p := pterm.DefaultInteractiveSelect.WithDefaultText("default")
 	testza.AssertEqual(t, p.DefaultText, "default")
@@ -51,3 +159,368 @@ func TestInteractiveSelectPrinter_WithMaxHeight(t *testing.T) {
 	p := pterm.DefaultInteractiveSelect.WithMaxHeight(1337)
 	testza.AssertEqual(t, p.MaxHeight, 1337)
 }
+
+func TestInteractiveSelectPrinter_WithFilter(t *testing.T) {
+	p := pterm.DefaultInteractiveSelect.WithFilter(false)
+	testza.AssertFalse(t, p.Filter)
+}
+
+func TestInteractiveSelectPrinter_WithFilterStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultInteractiveSelect.WithFilterStyle(s)
+	testza.AssertEqual(t, s, p.FilterStyle)
+}
+
+func TestInteractiveSelectPrinter_ShowWithContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b"}).ShowWithContext(ctx)
+	testza.AssertEqual(t, "", result)
+	testza.AssertErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInteractiveSelectPrinter_ShowWithContext_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b"}).ShowWithContext(ctx)
+	testza.AssertEqual(t, "", result)
+	testza.AssertErrorIs(t, err, context.Canceled)
+}
+
+func TestInteractiveSelectPrinter_CursorManagement(t *testing.T) {
+	for _, manage := range []bool{true, false} {
+		f, err := os.CreateTemp(t.TempDir(), "cursor")
+		testza.AssertNoError(t, err)
+		cursor.SetTarget(f)
+		pterm.SetCursorManagement(manage)
+
+		go func() {
+			keyboard.SimulateKeyPress(keys.Enter)
+		}()
+		_, err = pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b"}).Show()
+		testza.AssertNoError(t, err)
+
+		cursor.SetTarget(os.Stdout)
+		pterm.SetCursorManagement(true)
+		content, _ := os.ReadFile(f.Name())
+		f.Close()
+
+		if manage {
+			testza.AssertContains(t, string(content), "\x1b[?25l")
+		} else {
+			testza.AssertNotContains(t, string(content), "\x1b[?25l")
+			testza.AssertNotContains(t, string(content), "\x1b[?25h")
+		}
+	}
+}
+
+func TestInteractiveSelectPrinter_ShowWithIndex(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, index, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "a"}).ShowWithIndex()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "a", result)
+	testza.AssertEqual(t, 2, index)
+}
+
+func TestInteractiveSelectPrinter_ShowWithIndex_Filter(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress("an")
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, index, _ := pterm.DefaultInteractiveSelect.WithOptions([]string{"mango", "cherry", "banana"}).ShowWithIndex()
+	testza.AssertEqual(t, "banana", result)
+	testza.AssertEqual(t, 2, index)
+}
+
+func TestInteractiveSelectPrinter_ShowWithIndex_SelectOptions(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, index, _ := pterm.DefaultInteractiveSelect.WithSelectOptions(groupedSelectOptions).ShowWithIndex()
+	testza.AssertEqual(t, "monitoring", result)
+	testza.AssertEqual(t, 4, index)
+}
+
+func TestInteractiveSelectPrinter_WithRenderItem(t *testing.T) {
+	type call struct {
+		option   string
+		index    int
+		selected bool
+	}
+	var calls []call
+	renderItem := func(option string, index int, selected bool) string {
+		calls = append(calls, call{option, index, selected})
+		if selected {
+			return "-> " + option
+		}
+		return "   " + option + "\nsecond line"
+	}
+
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, index, _ := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "c"}).WithRenderItem(renderItem).ShowWithIndex()
+	testza.AssertEqual(t, "b", result)
+	testza.AssertEqual(t, 1, index)
+
+	testza.AssertContains(t, calls, call{"a", 0, true})
+	testza.AssertContains(t, calls, call{"b", 1, true})
+	testza.AssertContains(t, calls, call{"c", 2, false})
+}
+
+func TestInteractiveSelectPrinter_WithShowScrollIndicators(t *testing.T) {
+	p := pterm.DefaultInteractiveSelect.WithShowScrollIndicators()
+	testza.AssertTrue(t, p.ShowScrollIndicators)
+	testza.AssertFalse(t, pterm.DefaultInteractiveSelect.ShowScrollIndicators)
+}
+
+func TestInteractiveSelectPrinter_WithScrollIndicatorStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultInteractiveSelect.WithScrollIndicatorStyle(s)
+	testza.AssertEqual(t, s, p.ScrollIndicatorStyle)
+}
+
+var pagedOptions = []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}
+
+func TestInteractiveSelectPrinter_Show_PageDown(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.PgDown)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithOptions(pagedOptions).Show()
+	testza.AssertEqual(t, "f", result)
+}
+
+func TestInteractiveSelectPrinter_Show_PageDownStopsAtLastOption(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.PgDown)
+		keyboard.SimulateKeyPress(keys.PgDown)
+		keyboard.SimulateKeyPress(keys.PgDown)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithOptions(pagedOptions).Show()
+	testza.AssertEqual(t, "l", result)
+}
+
+func TestInteractiveSelectPrinter_Show_PageUpStopsAtFirstOption(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.PgUp)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithOptions(pagedOptions).WithDefaultOption("c").Show()
+	testza.AssertEqual(t, "a", result)
+}
+
+func TestInteractiveSelectPrinter_Show_PageSkipsHeaders(t *testing.T) {
+	options := []pterm.SelectOption{
+		{Text: "a"}, {Text: "b"},
+		{Text: "Header", IsHeader: true},
+		{Text: "c"}, {Text: "d"},
+	}
+	go func() {
+		keyboard.SimulateKeyPress(keys.PgDown)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithSelectOptions(options).WithMaxHeight(2).Show()
+	testza.AssertEqual(t, "c", result)
+}
+
+func TestInteractiveSelectPrinter_Show_PageKeepsSelectionVisible(t *testing.T) {
+	var rendered []string
+	renderItem := func(option string, index int, selected bool) string {
+		if selected {
+			option = ">" + option
+		}
+		rendered = append(rendered, option)
+		return option
+	}
+
+	go func() {
+		keyboard.SimulateKeyPress(keys.PgDown)
+		keyboard.SimulateKeyPress(keys.PgDown)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveSelect.WithOptions(pagedOptions).WithRenderItem(renderItem).Show()
+	testza.AssertEqual(t, "k", result)
+	// the last render shows the window of five options, which ends with the selected option
+	testza.AssertEqual(t, []string{"g", "h", "i", "j", ">k"}, rendered[len(rendered)-5:])
+}
+
+func TestInteractiveSelectPrinter_WithAutoSelectSingle(t *testing.T) {
+	p := pterm.DefaultInteractiveSelect.WithAutoSelectSingle()
+	testza.AssertTrue(t, p.AutoSelectSingle)
+	testza.AssertFalse(t, pterm.DefaultInteractiveSelect.AutoSelectSingle)
+}
+
+func TestInteractiveSelectPrinter_AutoSelectSingle(t *testing.T) {
+	options := []pterm.SelectOption{{Text: "Group", IsHeader: true}, {Text: "only"}}
+	result, index, err := pterm.DefaultInteractiveSelect.WithSelectOptions(options).WithAutoSelectSingle().ShowWithIndex()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "only", result)
+	testza.AssertEqual(t, 1, index)
+}
+
+func TestInteractiveSelectPrinter_AutoSelectSingleWaitsForMultipleOptions(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b"}).WithAutoSelectSingle().Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "b", result)
+}
+
+func TestInteractiveSelectPrinter_NoOptions(t *testing.T) {
+	_, err := pterm.DefaultInteractiveSelect.WithOptions([]string{}).WithAutoSelectSingle().Show()
+	testza.AssertErrorIs(t, err, pterm.ErrNoOptions)
+}
+
+func TestInteractiveSelectPrinter_WithFallbackDisabled(t *testing.T) {
+	p := pterm.DefaultInteractiveSelect.WithFallbackDisabled()
+	testza.AssertTrue(t, p.FallbackDisabled)
+	testza.AssertFalse(t, pterm.DefaultInteractiveSelect.FallbackDisabled)
+}
+
+func TestInteractiveSelectPrinter_WithMouse(t *testing.T) {
+	p := pterm.DefaultInteractiveSelect.WithMouse()
+	testza.AssertTrue(t, p.Mouse)
+	testza.AssertFalse(t, pterm.DefaultInteractiveSelect.Mouse)
+}
+
+func TestInteractiveSelectPrinter_WithMouse_KeyboardStillWorks(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "c"}).WithMouse().Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "b", result)
+}
+
+func TestInteractiveSelectPrinter_LineInput(t *testing.T) {
+	simulateLineInput(t, "2\n")
+	result, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "c"}).Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "b", result)
+}
+
+func TestInteractiveSelectPrinter_LineInputDefault(t *testing.T) {
+	simulateLineInput(t, "\n")
+	result, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "c"}).WithDefaultOption("c").Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "c", result)
+}
+
+func TestInteractiveSelectPrinter_LineInputSkipsHeaders(t *testing.T) {
+	simulateLineInput(t, "2")
+	options := []pterm.SelectOption{{Text: "Group", IsHeader: true}, {Text: "a"}, {Text: "Other", IsHeader: true}, {Text: "b"}}
+	result, index, err := pterm.DefaultInteractiveSelect.WithSelectOptions(options).ShowWithIndex()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "b", result)
+	testza.AssertEqual(t, 3, index)
+}
+
+func TestInteractiveSelectPrinter_LineInputInvalid(t *testing.T) {
+	for _, input := range []string{"x\n", "0\n", "4\n"} {
+		simulateLineInput(t, input)
+		_, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "c"}).Show()
+		testza.AssertErrorIs(t, err, pterm.ErrInvalidInput)
+	}
+}
+
+func TestInteractiveSelectPrinter_LineInputFallbackDisabled(t *testing.T) {
+	simulateLineInput(t, "2\n")
+	go func() {
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Down)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, err := pterm.DefaultInteractiveSelect.WithOptions([]string{"a", "b", "c"}).WithFallbackDisabled().Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "c", result)
+}
+
+func TestInteractiveSelectPrinter_WithShortcutStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultInteractiveSelect.WithShortcutStyle(s)
+	testza.AssertEqual(t, s, p.ShortcutStyle)
+	testza.AssertEqual(t, &pterm.ThemeDefault.SecondaryStyle, pterm.DefaultInteractiveSelect.ShortcutStyle)
+}
+
+func TestInteractiveSelectPrinter_WithSelectOnShortcut(t *testing.T) {
+	p := pterm.DefaultInteractiveSelect.WithSelectOnShortcut()
+	testza.AssertTrue(t, p.SelectOnShortcut)
+	testza.AssertFalse(t, pterm.DefaultInteractiveSelect.SelectOnShortcut)
+}
+
+var shortcutOptions = []pterm.SelectOption{{Text: "Keep", Shortcut: 'k'}, {Text: "Overwrite", Shortcut: 'o'}, {Text: "Skip"}, {Text: "Delete", Shortcut: 'd'}}
+
+func TestInteractiveSelectPrinter_ShortcutJumpsToOption(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress('d')
+		keyboard.SimulateKeyPress(keys.Up)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, err := pterm.DefaultInteractiveSelect.WithSelectOptions(shortcutOptions).WithFilter(false).Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Skip", result)
+}
+
+func TestInteractiveSelectPrinter_ShortcutSelectsOnSecondPress(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress('O')
+		keyboard.SimulateKeyPress('o')
+	}()
+	result, index, err := pterm.DefaultInteractiveSelect.WithSelectOptions(shortcutOptions).WithFilter(false).ShowWithIndex()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Overwrite", result)
+	testza.AssertEqual(t, 1, index)
+}
+
+func TestInteractiveSelectPrinter_SelectOnShortcut(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress('d')
+	}()
+	result, err := pterm.DefaultInteractiveSelect.WithSelectOptions(shortcutOptions).WithFilter(false).WithSelectOnShortcut().Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Delete", result)
+}
+
+func TestInteractiveSelectPrinter_ShortcutWithFilter(t *testing.T) {
+	go func() {
+		// without alt, the key filters the options
+		keyboard.SimulateKeyPress('s')
+		keyboard.SimulateKeyPress(keys.Backspace)
+		keyboard.SimulateKeyPress(keys.Key{Code: keys.RuneKey, Runes: []rune{'d'}, AltPressed: true})
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, err := pterm.DefaultInteractiveSelect.WithSelectOptions(shortcutOptions).Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Delete", result)
+}
+
+func TestInteractiveSelectPrinter_DuplicateShortcut(t *testing.T) {
+	options := []pterm.SelectOption{{Text: "Keep", Shortcut: 'k'}, {Text: "Kill", Shortcut: 'K'}}
+	_, err := pterm.DefaultInteractiveSelect.WithSelectOptions(options).Show()
+	testza.AssertErrorIs(t, err, pterm.ErrDuplicateShortcut)
+	testza.AssertContains(t, err.Error(), "Kill")
+}
+
+func TestInteractiveSelectPrinter_LineInputShortcut(t *testing.T) {
+	simulateLineInput(t, "o\n")
+	var result string
+	out := captureStdout(func(w io.Writer) {
+		result, _ = pterm.DefaultInteractiveSelect.WithSelectOptions(shortcutOptions).Show()
+	})
+	testza.AssertEqual(t, "Overwrite", result)
+	testza.AssertContains(t, pterm.RemoveColorFromString(out), "1) [k] Keep")
+	testza.AssertContains(t, pterm.RemoveColorFromString(out), "3) Skip")
+}
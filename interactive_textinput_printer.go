@@ -1,11 +1,13 @@
 package pterm
 
 import (
+	"context"
 	"strings"
+	"unicode/utf8"
 
 	"atomicgo.dev/cursor"
-	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
+	"go.uber.org/atomic"
 
 	"github.com/pterm/pterm/internal"
 )
@@ -23,11 +25,18 @@ type InteractiveTextInputPrinter struct {
 	TextStyle   *Style
 	DefaultText string
 	MultiLine   bool
+	Mask        rune
+	// Validator is called with the input, when it is submitted.
+	// If it returns an error, the error is shown below the input, and the input is not submitted.
+	Validator func(input string) error
+	// If ValidateOnChange is true, the Validator is also called after every change of the input.
+	ValidateOnChange bool
 
-	input      []string
-	cursorXPos int
-	cursorYPos int
-	text       string
+	input         []string
+	cursorXPos    int
+	cursorYPos    int
+	text          string
+	validationErr error
 }
 
 // WithDefaultText sets the default text.
@@ -48,8 +57,39 @@ func (p InteractiveTextInputPrinter) WithMultiLine(multiLine ...bool) *Interacti
 	return &p
 }
 
+// WithMask sets a mask character, which is displayed instead of every typed character.
+// The real input is still returned. Set the mask to 0 to display the input as it is typed.
+func (p InteractiveTextInputPrinter) WithMask(mask rune) *InteractiveTextInputPrinter {
+	p.Mask = mask
+	return &p
+}
+
+// WithValidator sets a function, which validates the input when it is submitted.
+// If the function returns an error, the error is shown below the input, and the user can correct the input.
+// The returned input always passes the validator.
+func (p InteractiveTextInputPrinter) WithValidator(validator func(input string) error) *InteractiveTextInputPrinter {
+	p.Validator = validator
+	return &p
+}
+
+// WithValidateOnChange sets if the input should be validated after every change, instead of only when it is submitted.
+func (p InteractiveTextInputPrinter) WithValidateOnChange(b ...bool) *InteractiveTextInputPrinter {
+	p.ValidateOnChange = internal.WithBoolean(b)
+	return &p
+}
+
 // Show shows the interactive select menu and returns the selected entry.
 func (p InteractiveTextInputPrinter) Show(text ...string) (string, error) {
+	return p.ShowWithContext(context.Background(), text...)
+}
+
+// ShowWithContext shows the interactive text input, like Show.
+// If the context is done before the input was submitted, the input stops and the error of the context is returned.
+func (p InteractiveTextInputPrinter) ShowWithContext(ctx context.Context, text ...string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
 	// should be the first defer statement to make sure it is executed last
 	// and all the needed cleanup can be done before
 	cancel, exit := internal.NewCancelationSignal()
@@ -79,18 +119,29 @@ func (p InteractiveTextInputPrinter) Show(text ...string) (string, error) {
 		cursor.Right(len(RemoveColorFromString(areaText)))
 	}
 
-	err = keyboard.Listen(func(key keys.Key) (stop bool, err error) {
+	cancelled := atomic.NewBool(false)
+	aborted := atomic.NewBool(false)
+	stopOnDone := stopKeyboardListenerOnDone(ctx, cancelled)
+	defer stopOnDone()
+
+	err = listenKeyboard(func(key keys.Key) (stop bool, err error) {
+		if cancelled.Load() {
+			aborted.Store(true)
+			return true, nil
+		}
+
 		if !p.MultiLine {
 			p.cursorYPos = 0
 		}
 		if len(p.input) == 0 {
 			p.input = append(p.input, "")
 		}
+		previous := p.value()
 
 		switch key.Code {
 		case keys.Tab:
 			if p.MultiLine {
-				return true, nil
+				return p.submit(area), nil
 			}
 		case keys.Enter:
 			if p.MultiLine {
@@ -107,7 +158,7 @@ func (p InteractiveTextInputPrinter) Show(text ...string) (string, error) {
 				cursor.Down(1)
 				cursor.StartOfLine()
 			} else {
-				return true, nil
+				return p.submit(area), nil
 			}
 		case keys.RuneKey:
 			p.input[p.cursorYPos] = string(append([]rune(p.input[p.cursorYPos])[:len([]rune(p.input[p.cursorYPos]))+p.cursorXPos], append([]rune(key.String()), []rune(p.input[p.cursorYPos])[len([]rune(p.input[p.cursorYPos]))+p.cursorXPos:]...)...))
@@ -173,6 +224,9 @@ func (p InteractiveTextInputPrinter) Show(text ...string) (string, error) {
 			}
 		}
 
+		if p.Validator != nil && p.ValidateOnChange && p.value() != previous {
+			p.validationErr = p.Validator(p.value())
+		}
 		p.updateArea(area)
 
 		return false, nil
@@ -184,15 +238,27 @@ func (p InteractiveTextInputPrinter) Show(text ...string) (string, error) {
 	// Add new line
 	Println()
 
-	for i, s := range p.input {
-		if i < len(p.input)-1 {
-			areaText += s + "\n"
-		} else {
-			areaText += s
-		}
+	if aborted.Load() {
+		return "", ctx.Err()
 	}
 
-	return strings.ReplaceAll(areaText, p.text, ""), nil
+	return p.value(), nil
+}
+
+// value returns the current input.
+func (p InteractiveTextInputPrinter) value() string {
+	return strings.Join(p.input, "\n")
+}
+
+// submit validates the input and returns true, if the input can be submitted.
+// Otherwise, the validation error is shown below the input.
+func (p *InteractiveTextInputPrinter) submit(area *AreaPrinter) bool {
+	if p.Validator == nil {
+		return true
+	}
+	p.validationErr = p.Validator(p.value())
+	p.updateArea(area)
+	return p.validationErr == nil
 }
 
 func (p InteractiveTextInputPrinter) updateArea(area *AreaPrinter) string {
@@ -202,23 +268,37 @@ func (p InteractiveTextInputPrinter) updateArea(area *AreaPrinter) string {
 	areaText := p.text
 	for i, s := range p.input {
 		if i < len(p.input)-1 {
-			areaText += s + "\n"
+			areaText += p.maskInput(s) + "\n"
 		} else {
-			areaText += s
+			areaText += p.maskInput(s)
 		}
 	}
 	if p.cursorXPos+internal.GetStringMaxWidth(p.input[p.cursorYPos]) < 1 {
 		p.cursorXPos = -internal.GetStringMaxWidth(p.input[p.cursorYPos])
 	}
+	inputWidth := internal.GetStringMaxWidth(areaText)
+	errorLines := 0
+	if p.validationErr != nil {
+		areaText += "\n" + ThemeDefault.ErrorMessageStyle.Sprint(strings.ReplaceAll(p.validationErr.Error(), "\n", " "))
+		errorLines = 1
+	}
 
 	cursor.StartOfLine()
 	area.Update(areaText)
-	cursor.Up(len(p.input) - p.cursorYPos)
+	cursor.Up(len(p.input) - p.cursorYPos + errorLines)
 	cursor.StartOfLine()
 	if p.MultiLine {
-		cursor.Right(internal.GetStringMaxWidth(p.input[p.cursorYPos]) + p.cursorXPos)
+		cursor.Right(internal.GetStringMaxWidth(p.maskInput(p.input[p.cursorYPos])) + p.cursorXPos)
 	} else {
-		cursor.Right(internal.GetStringMaxWidth(areaText) + p.cursorXPos)
+		cursor.Right(inputWidth + p.cursorXPos)
 	}
 	return areaText
 }
+
+// maskInput returns a line of the input as it is displayed.
+func (p InteractiveTextInputPrinter) maskInput(s string) string {
+	if p.Mask == 0 {
+		return s
+	}
+	return strings.Repeat(string(p.Mask), utf8.RuneCountInString(s))
+}
@@ -3,8 +3,14 @@
 package pterm_test
 
 import (
+	"context"
+	"errors"
+	"strconv"
 	"testing"
+	"time"
 
+	"atomicgo.dev/keyboard"
+	"atomicgo.dev/keyboard/keys"
 	"github.com/MarvinJWendt/testza"
 
 	"github.com/pterm/pterm"
@@ -30,3 +36,91 @@ func TestInteractiveTextInputPrinter_WithTextStyle(t *testing.T) {
 	p := pterm.DefaultInteractiveTextInput.WithTextStyle(style)
 	testza.AssertEqual(t, p.TextStyle, style)
 }
+
+func TestInteractiveTextInputPrinter_WithMask(t *testing.T) {
+	p := pterm.DefaultInteractiveTextInput.WithMask('*')
+	testza.AssertEqual(t, '*', p.Mask)
+}
+
+func TestInteractiveTextInputPrinter_Show_WithMask(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress("secret")
+		keyboard.SimulateKeyPress(keys.Backspace)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveTextInput.WithMask('*').Show()
+	testza.AssertEqual(t, "secre", result)
+}
+
+func TestInteractiveTextInputPrinter_WithValidator(t *testing.T) {
+	p := pterm.DefaultInteractiveTextInput.WithValidator(func(string) error { return nil })
+	testza.AssertNotNil(t, p.Validator)
+	testza.AssertNil(t, pterm.DefaultInteractiveTextInput.Validator)
+}
+
+func TestInteractiveTextInputPrinter_WithValidateOnChange(t *testing.T) {
+	p := pterm.DefaultInteractiveTextInput.WithValidateOnChange()
+	testza.AssertTrue(t, p.ValidateOnChange)
+}
+
+// validatePort returns an error, if the input is not a number.
+func validatePort(input string) error {
+	if _, err := strconv.Atoi(input); err != nil {
+		return errors.New("not a valid port")
+	}
+	return nil
+}
+
+func TestInteractiveTextInputPrinter_Show_WithValidator(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress("80a")
+		keyboard.SimulateKeyPress(keys.Enter)
+		keyboard.SimulateKeyPress(keys.Backspace)
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, err := pterm.DefaultInteractiveTextInput.WithValidator(validatePort).Show()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "80", result)
+}
+
+func TestInteractiveTextInputPrinter_Show_WithValidatorMultiLine(t *testing.T) {
+	go func() {
+		keyboard.SimulateKeyPress(keys.Tab)
+		keyboard.SimulateKeyPress("443")
+		keyboard.SimulateKeyPress(keys.Tab)
+	}()
+	result, _ := pterm.DefaultInteractiveTextInput.WithMultiLine().WithValidator(validatePort).Show()
+	testza.AssertEqual(t, "443", result)
+}
+
+func TestInteractiveTextInputPrinter_Show_WithValidateOnChange(t *testing.T) {
+	var calls []string
+	validator := func(input string) error {
+		calls = append(calls, input)
+		return validatePort(input)
+	}
+	go func() {
+		keyboard.SimulateKeyPress("1")
+		keyboard.SimulateKeyPress("2")
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+	result, _ := pterm.DefaultInteractiveTextInput.WithValidator(validator).WithValidateOnChange().Show()
+	testza.AssertEqual(t, "12", result)
+	testza.AssertEqual(t, []string{"1", "12", "12"}, calls)
+}
+
+func TestInteractiveTextInputPrinter_ShowWithContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result, err := pterm.DefaultInteractiveTextInput.ShowWithContext(ctx)
+	testza.AssertEqual(t, "", result)
+	testza.AssertErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInteractiveTextInputPrinter_ShowWithContext_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := pterm.DefaultInteractiveTextInput.ShowWithContext(ctx)
+	testza.AssertEqual(t, "", result)
+	testza.AssertErrorIs(t, err, context.Canceled)
+}
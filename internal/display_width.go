@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// DisplayWidth returns the number of columns, which s takes up in a terminal.
+// Double-width runes count as 2, and ANSI escape sequences and OSC 8 hyperlinks are ignored.
+func DisplayWidth(s string) int {
+	if !strings.ContainsRune(s, '\x1b') {
+		return runewidth.StringWidth(s)
+	}
+
+	var width int
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if end := escapeSequenceEnd(runes, i); end > i {
+			i = end
+			continue
+		}
+		width += runewidth.RuneWidth(runes[i])
+	}
+	return width
+}
+
+// escapeSequenceEnd returns the index of the last rune of the escape sequence, which starts at i.
+// If no escape sequence starts at i, i is returned.
+// CSI sequences, like colors, and OSC sequences, like hyperlinks, are detected.
+func escapeSequenceEnd(runes []rune, i int) int {
+	if runes[i] != '\x1b' || i+1 >= len(runes) {
+		return i
+	}
+	switch runes[i+1] {
+	case '[':
+		j := i + 2
+		for j < len(runes) && (runes[j] < 0x40 || runes[j] > 0x7e) {
+			j++
+		}
+		if j >= len(runes) {
+			return len(runes) - 1
+		}
+		return j
+	case ']':
+		// OSC sequences end with BEL or ESC \
+		for j := i + 2; j < len(runes); j++ {
+			if runes[j] == '\a' {
+				return j
+			}
+			if runes[j] == '\x1b' && j+1 < len(runes) && runes[j+1] == '\\' {
+				return j + 1
+			}
+		}
+		return len(runes) - 1
+	}
+	return i
+}
@@ -0,0 +1,18 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm/internal"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	testza.AssertEqual(t, 11, internal.DisplayWidth("Hello World"))
+	testza.AssertEqual(t, 4, internal.DisplayWidth("你好"))
+	testza.AssertEqual(t, 5, internal.DisplayWidth("\x1b[31mHello\x1b[0m"))
+	testza.AssertEqual(t, 4, internal.DisplayWidth("\x1b]8;;https://pterm.sh\x1b\\link\x1b]8;;\x1b\\"))
+	// unterminated escape sequences don't count
+	testza.AssertEqual(t, 2, internal.DisplayWidth("ab\x1b[31"))
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// FormatDuration returns a compact representation of the duration, like "1h2m3s".
+// Zero units are left out, so one hour is returned as "1h". Durations below one second are returned in milliseconds.
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		return "-" + formatDuration(magnitude(int64(d)))
+	}
+	return formatDuration(uint64(d))
+}
+
+// formatDuration formats a duration of d nanoseconds, like FormatDuration.
+func formatDuration(d uint64) string {
+	if d < uint64(time.Second) {
+		return time.Duration(d).Round(time.Millisecond).String()
+	}
+
+	seconds := d / uint64(time.Second)
+	if d%uint64(time.Second) >= uint64(time.Second)/2 {
+		seconds++
+	}
+	hours := seconds / 3600
+	minutes := seconds % 3600 / 60
+	seconds %= 60
+
+	var ret string
+	if hours > 0 {
+		ret += strconv.FormatUint(hours, 10) + "h"
+	}
+	if minutes > 0 {
+		ret += strconv.FormatUint(minutes, 10) + "m"
+	}
+	if seconds > 0 {
+		ret += strconv.FormatUint(seconds, 10) + "s"
+	}
+	return ret
+}
+
+// FormatBytes returns a human-readable representation of the byte count, like "1.5 KiB".
+// If si is true, units of 1000 (kB, MB, ...) are used, otherwise units of 1024 (KiB, MiB, ...).
+func FormatBytes(n int64, si bool) string {
+	if n < 0 {
+		return "-" + formatBytes(magnitude(n), si)
+	}
+	return formatBytes(uint64(n), si)
+}
+
+// formatBytes formats the byte count, like FormatBytes.
+func formatBytes(n uint64, si bool) string {
+	unit, prefixes, suffix := uint64(1024), "KMGTPE", "iB"
+	if si {
+		unit, prefixes, suffix = 1000, "kMGTPE", "B"
+	}
+
+	if n < unit {
+		return strconv.FormatUint(n, 10) + " B"
+	}
+
+	value, exp := float64(n)/float64(unit), 0
+	// the unit is chosen after rounding, so that a value like 1023.95 KiB is returned as "1.0 MiB"
+	for math.Round(value*10)/10 >= float64(unit) && exp < len(prefixes)-1 {
+		value /= float64(unit)
+		exp++
+	}
+	return fmt.Sprintf("%.1f %c%s", value, prefixes[exp], suffix)
+}
+
+// magnitude returns the absolute value of the negative number n.
+// It's unsigned, because the absolute value of math.MinInt64 doesn't fit into an int64.
+func magnitude(n int64) uint64 {
+	return uint64(-(n + 1)) + 1
+}
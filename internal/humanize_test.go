@@ -0,0 +1,57 @@
+package internal_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm/internal"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		want     string
+	}{
+		{0, "0s"},
+		{150 * time.Millisecond, "150ms"},
+		{3 * time.Second, "3s"},
+		{time.Hour, "1h"},
+		{time.Hour + 2*time.Minute + 3*time.Second, "1h2m3s"},
+		{time.Hour + 3*time.Second, "1h3s"},
+		{90*time.Second + 600*time.Millisecond, "1m31s"},
+		{-5 * time.Second, "-5s"},
+		{math.MaxInt64, "2562047h47m17s"},
+		{math.MinInt64, "-2562047h47m17s"},
+	}
+	for _, tt := range tests {
+		testza.AssertEqual(t, tt.want, internal.FormatDuration(tt.duration))
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		si   bool
+		want string
+	}{
+		{0, false, "0 B"},
+		{1023, false, "1023 B"},
+		{1024, false, "1.0 KiB"},
+		{1536, false, "1.5 KiB"},
+		{5 * 1024 * 1024 * 1024, false, "5.0 GiB"},
+		{999, true, "999 B"},
+		{1000, true, "1.0 kB"},
+		{2500000, true, "2.5 MB"},
+		{-2048, false, "-2.0 KiB"},
+		{1048575, false, "1.0 MiB"},
+		{999999, true, "1.0 MB"},
+		{math.MaxInt64, false, "8.0 EiB"},
+		{math.MinInt64, false, "-8.0 EiB"},
+	}
+	for _, tt := range tests {
+		testza.AssertEqual(t, tt.want, internal.FormatBytes(tt.n, tt.si))
+	}
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"github.com/mattn/go-runewidth"
+)
+
+// SplitStringByWidth splits a string into parts, which are at most width cells wide.
+// Color codes and hyperlinks are kept and do not count towards the width.
+func SplitStringByWidth(s string, width int) []string {
+	if width <= 0 {
+		return []string{s}
+	}
+
+	var parts []string
+	var current string
+	var currentWidth int
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if j := escapeSequenceEnd(runes, i); j > i {
+			current += string(runes[i : j+1])
+			i = j
+			continue
+		}
+		w := runewidth.RuneWidth(runes[i])
+		if currentWidth+w > width && currentWidth > 0 {
+			parts = append(parts, current)
+			current = ""
+			currentWidth = 0
+		}
+		current += string(runes[i])
+		currentWidth += w
+	}
+	parts = append(parts, current)
+
+	return parts
+}
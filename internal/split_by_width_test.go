@@ -0,0 +1,16 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm/internal"
+)
+
+func TestSplitStringByWidth(t *testing.T) {
+	testza.AssertEqual(t, []string{"Hello"}, internal.SplitStringByWidth("Hello", 5))
+	testza.AssertEqual(t, []string{"Hel", "lo"}, internal.SplitStringByWidth("Hello", 3))
+	testza.AssertEqual(t, []string{"你", "好"}, internal.SplitStringByWidth("你好", 3))
+	testza.AssertEqual(t, []string{"\x1b[31mHe", "ll", "o\x1b[0m"}, internal.SplitStringByWidth("\x1b[31mHello\x1b[0m", 2))
+}
@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"atomicgo.dev/keyboard/keys"
+)
+
+const (
+	// EnableMouseReporting makes the terminal report mouse presses, releases and the mouse wheel as SGR mouse events.
+	EnableMouseReporting = "\x1b[?1000h\x1b[?1006h"
+	// DisableMouseReporting restores the mouse handling of the terminal.
+	DisableMouseReporting = "\x1b[?1006l\x1b[?1000l"
+	// RequestCursorPosition makes the terminal report the position of the cursor.
+	RequestCursorPosition = "\x1b[6n"
+)
+
+// MouseButton is the button of a MouseEvent.
+type MouseButton int
+
+// Mouse buttons, as they are reported by SGR mouse reporting.
+const (
+	MouseLeft      MouseButton = 0
+	MouseMiddle    MouseButton = 1
+	MouseRight     MouseButton = 2
+	MouseWheelUp   MouseButton = 64
+	MouseWheelDown MouseButton = 65
+)
+
+// MouseEvent is a mouse event, which was reported by the terminal. Column and Row start at 1.
+type MouseEvent struct {
+	Button   MouseButton
+	Column   int
+	Row      int
+	Released bool
+}
+
+// TerminalInput is a key press, a mouse event or a cursor position report, which was read from a terminal in raw mode.
+type TerminalInput struct {
+	Key keys.Key
+	// Mouse is set, if the input is a mouse event.
+	Mouse *MouseEvent
+	// CursorRow is set, if the input is a cursor position report. It starts at 1.
+	CursorRow int
+}
+
+// ParseTerminalInput splits the input, which was read from a terminal, into key presses, mouse events and cursor position reports.
+// An incomplete escape sequence at the end is returned as rest, so that it can be completed by the next read.
+// Unknown escape sequences are skipped.
+func ParseTerminalInput(b []byte) (inputs []TerminalInput, rest []byte) {
+	for len(b) > 0 {
+		input, n, ok := parseTerminalInput(b)
+		if n == 0 {
+			return inputs, b
+		}
+		if ok {
+			inputs = append(inputs, input)
+		}
+		b = b[n:]
+	}
+	return inputs, nil
+}
+
+// parseTerminalInput parses the first input of b and returns the number of bytes it takes up.
+// n is zero, if b starts with an incomplete escape sequence. ok is false, if the input is unknown.
+func parseTerminalInput(b []byte) (input TerminalInput, n int, ok bool) {
+	switch {
+	case b[0] == 0x1b && len(b) == 1:
+		return TerminalInput{Key: keys.Key{Code: keys.Escape}}, 1, true
+	case b[0] == 0x1b && b[1] == '[':
+		return parseCSI(b)
+	case b[0] == 0x1b && b[1] == 'O':
+		if len(b) < 3 {
+			return input, 0, false
+		}
+		code, ok := map[byte]keys.KeyCode{'A': keys.Up, 'B': keys.Down, 'C': keys.Right, 'D': keys.Left, 'H': keys.Home, 'F': keys.End}[b[2]]
+		return TerminalInput{Key: keys.Key{Code: code}}, 3, ok
+	case b[0] == 0x1b:
+		input, n, ok = parseTerminalInput(b[1:])
+		if n == 0 {
+			return input, 0, false
+		}
+		input.Key.AltPressed = true
+		return input, n + 1, ok && input.Mouse == nil && input.CursorRow == 0
+	case b[0] == 0x08:
+		return TerminalInput{Key: keys.Key{Code: keys.Backspace}}, 1, true
+	case b[0] == ' ':
+		return TerminalInput{Key: keys.Key{Code: keys.Space, Runes: []rune{' '}}}, 1, true
+	case b[0] < 0x20 || b[0] == 0x7f:
+		return TerminalInput{Key: keys.Key{Code: keys.KeyCode(b[0])}}, 1, true
+	}
+
+	if !utf8.FullRune(b) {
+		return input, 0, false
+	}
+	r, size := utf8.DecodeRune(b)
+	if r == utf8.RuneError {
+		return input, size, false
+	}
+	return TerminalInput{Key: keys.Key{Code: keys.RuneKey, Runes: []rune{r}}}, size, true
+}
+
+// parseCSI parses the control sequence at the start of b, like "\x1b[A", "\x1b[<0;10;5M" or "\x1b[12;1R".
+func parseCSI(b []byte) (input TerminalInput, n int, ok bool) {
+	end := -1
+	for i := 2; i < len(b); i++ {
+		if b[i] >= 0x40 && b[i] <= 0x7e {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return input, 0, false
+	}
+	n = end + 1
+	params, final := string(b[2:end]), b[end]
+
+	if strings.HasPrefix(params, "<") && (final == 'M' || final == 'm') {
+		values, ok := parseParams(strings.TrimPrefix(params, "<"), 3)
+		if !ok {
+			return input, n, false
+		}
+		return TerminalInput{Mouse: &MouseEvent{
+			Button:   MouseButton(values[0]),
+			Column:   values[1],
+			Row:      values[2],
+			Released: final == 'm',
+		}}, n, true
+	}
+
+	if final == 'R' {
+		if values, ok := parseParams(params, 2); ok {
+			return TerminalInput{CursorRow: values[0]}, n, true
+		}
+	}
+
+	code, modifier, _ := strings.Cut(params, ";")
+	key := keys.Key{AltPressed: modifier == "3"}
+	switch final {
+	case 'A':
+		key.Code = keys.Up
+	case 'B':
+		key.Code = keys.Down
+	case 'C':
+		key.Code = keys.Right
+	case 'D':
+		key.Code = keys.Left
+	case 'H':
+		key.Code = keys.Home
+	case 'F':
+		key.Code = keys.End
+	case 'Z':
+		key.Code = keys.ShiftTab
+	case '~':
+		var known bool
+		key.Code, known = map[string]keys.KeyCode{
+			"1": keys.Home, "7": keys.Home,
+			"4": keys.End, "8": keys.End,
+			"3": keys.Delete,
+			"5": keys.PgUp,
+			"6": keys.PgDown,
+		}[code]
+		if !known {
+			return input, n, false
+		}
+	default:
+		return input, n, false
+	}
+	return TerminalInput{Key: key}, n, true
+}
+
+// parseParams parses exactly count numeric parameters, which are separated by ";".
+func parseParams(params string, count int) ([]int, bool) {
+	fields := strings.Split(params, ";")
+	if len(fields) != count {
+		return nil, false
+	}
+	values := make([]int, count)
+	for i, field := range fields {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, false
+		}
+		values[i] = value
+	}
+	return values, true
+}
@@ -0,0 +1,66 @@
+package internal_test
+
+import (
+	"testing"
+
+	"atomicgo.dev/keyboard/keys"
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm/internal"
+)
+
+func TestParseTerminalInput_Keys(t *testing.T) {
+	inputs, rest := internal.ParseTerminalInput([]byte("a\x1b[A\x1b[B\r \x7f\x03\x1b[5~\x1bOB\x1bx你"))
+
+	testza.AssertNil(t, rest)
+	testza.AssertEqual(t, []internal.TerminalInput{
+		{Key: keys.Key{Code: keys.RuneKey, Runes: []rune{'a'}}},
+		{Key: keys.Key{Code: keys.Up}},
+		{Key: keys.Key{Code: keys.Down}},
+		{Key: keys.Key{Code: keys.Enter}},
+		{Key: keys.Key{Code: keys.Space, Runes: []rune{' '}}},
+		{Key: keys.Key{Code: keys.Backspace}},
+		{Key: keys.Key{Code: keys.CtrlC}},
+		{Key: keys.Key{Code: keys.PgUp}},
+		{Key: keys.Key{Code: keys.Down}},
+		{Key: keys.Key{Code: keys.RuneKey, Runes: []rune{'x'}, AltPressed: true}},
+		{Key: keys.Key{Code: keys.RuneKey, Runes: []rune{'你'}}},
+	}, inputs)
+}
+
+func TestParseTerminalInput_MouseEvents(t *testing.T) {
+	inputs, rest := internal.ParseTerminalInput([]byte("\x1b[<0;10;5M\x1b[<0;10;5m\x1b[<64;1;2M\x1b[<65;1;2M"))
+
+	testza.AssertNil(t, rest)
+	testza.AssertEqual(t, []internal.TerminalInput{
+		{Mouse: &internal.MouseEvent{Button: internal.MouseLeft, Column: 10, Row: 5}},
+		{Mouse: &internal.MouseEvent{Button: internal.MouseLeft, Column: 10, Row: 5, Released: true}},
+		{Mouse: &internal.MouseEvent{Button: internal.MouseWheelUp, Column: 1, Row: 2}},
+		{Mouse: &internal.MouseEvent{Button: internal.MouseWheelDown, Column: 1, Row: 2}},
+	}, inputs)
+}
+
+func TestParseTerminalInput_CursorPositionReport(t *testing.T) {
+	inputs, rest := internal.ParseTerminalInput([]byte("\x1b[12;1R"))
+
+	testza.AssertNil(t, rest)
+	testza.AssertEqual(t, []internal.TerminalInput{{CursorRow: 12}}, inputs)
+}
+
+func TestParseTerminalInput_IncompleteSequence(t *testing.T) {
+	inputs, rest := internal.ParseTerminalInput([]byte("a\x1b[<64;1"))
+
+	testza.AssertEqual(t, []internal.TerminalInput{{Key: keys.Key{Code: keys.RuneKey, Runes: []rune{'a'}}}}, inputs)
+	testza.AssertEqual(t, []byte("\x1b[<64;1"), rest)
+
+	inputs, rest = internal.ParseTerminalInput(append(rest, ";2M"...))
+	testza.AssertNil(t, rest)
+	testza.AssertEqual(t, []internal.TerminalInput{{Mouse: &internal.MouseEvent{Button: internal.MouseWheelUp, Column: 1, Row: 2}}}, inputs)
+}
+
+func TestParseTerminalInput_SkipsUnknownSequences(t *testing.T) {
+	inputs, rest := internal.ParseTerminalInput([]byte("\x1b[200~a\x1b[?1;2c"))
+
+	testza.AssertNil(t, rest)
+	testza.AssertEqual(t, []internal.TerminalInput{{Key: keys.Key{Code: keys.RuneKey, Runes: []rune{'a'}}}}, inputs)
+}
@@ -4,15 +4,16 @@ import (
 	"strings"
 
 	"github.com/gookit/color"
+	"github.com/mattn/go-runewidth"
 )
 
 // AddTitleToLine adds a title to a site of a line ex: "─ This is the title ──────"
 func AddTitleToLine(title, line string, length int, left bool) string {
 	var ret string
 	if left {
-		ret += line + " " + title + " " + line + strings.Repeat(line, length-(4+len(color.ClearCode(title))))
+		ret += line + " " + title + " " + line + strings.Repeat(line, length-(4+runewidth.StringWidth(color.ClearCode(title))))
 	} else {
-		ret += strings.Repeat(line, length-(4+len(color.ClearCode(title)))) + line + " " + title + " " + line
+		ret += strings.Repeat(line, length-(4+runewidth.StringWidth(color.ClearCode(title)))) + line + " " + title + " " + line
 	}
 
 	return ret
@@ -21,7 +22,7 @@ func AddTitleToLine(title, line string, length int, left bool) string {
 // AddTitleToLineCenter adds a title to the center of a line ex: "─ This is the title ──────"
 func AddTitleToLineCenter(title, line string, length int) string {
 	var ret string
-	repeatString := length - (4 + len(color.ClearCode(title)))
+	repeatString := length - (4 + runewidth.StringWidth(color.ClearCode(title)))
 	unevenRepeatString := repeatString % 2
 
 	ret += strings.Repeat(line, repeatString/2) + line + " " + title + " " + line + strings.Repeat(line, repeatString/2+unevenRepeatString)
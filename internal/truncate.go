@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// TruncateString shortens a string to a maximum width and appends the ellipsis if the string was shortened.
+// Color codes and hyperlinks are kept and do not count towards the width.
+func TruncateString(s string, width int, ellipsis string) string {
+	if DisplayWidth(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+
+	ellipsisWidth := runewidth.StringWidth(ellipsis)
+	if ellipsisWidth > width {
+		return runewidth.Truncate(ellipsis, width, "")
+	}
+	budget := width - ellipsisWidth
+
+	var ret strings.Builder
+	var currentWidth int
+	truncated := false
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		// keep escape sequences, so that styles are reset correctly after the cut
+		if end := escapeSequenceEnd(runes, i); end > i {
+			ret.WriteString(string(runes[i : end+1]))
+			i = end
+			continue
+		}
+		if truncated {
+			continue
+		}
+		w := runewidth.RuneWidth(runes[i])
+		if currentWidth+w > budget {
+			ret.WriteString(ellipsis)
+			truncated = true
+			continue
+		}
+		ret.WriteRune(runes[i])
+		currentWidth += w
+	}
+
+	return ret.String()
+}
@@ -0,0 +1,25 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/gookit/color"
+
+	"github.com/pterm/pterm/internal"
+)
+
+func TestTruncateString(t *testing.T) {
+	testza.AssertEqual(t, "Hello", internal.TruncateString("Hello", 5, "…"))
+	testza.AssertEqual(t, "Hell…", internal.TruncateString("Hello World", 5, "…"))
+	testza.AssertEqual(t, "…", internal.TruncateString("Hello World", 1, "…"))
+	testza.AssertEqual(t, "", internal.TruncateString("Hello World", 0, "…"))
+	testza.AssertEqual(t, "你…", internal.TruncateString("你好世界", 4, "…"))
+}
+
+func TestTruncateStringKeepsColorCodes(t *testing.T) {
+	s := internal.TruncateString("\x1b[31mHello World\x1b[0m", 5, "…")
+
+	testza.AssertEqual(t, "Hell…", color.ClearCode(s))
+	testza.AssertEqual(t, "\x1b[31mHell…\x1b[0m", s)
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"strings"
+)
+
+// WrapWords wraps a string at spaces, so that no line is wider than width.
+// Words, which are wider than width, are split into multiple lines. Consecutive whitespace is collapsed.
+// Color codes and hyperlinks are kept and do not count towards the width.
+func WrapWords(s string, width int) string {
+	var words []string
+	for _, word := range strings.Fields(strings.TrimSpace(s)) {
+		// words, which are longer than a whole line, are broken into multiple parts
+		words = append(words, SplitStringByWidth(word, width)...)
+	}
+	if len(words) == 0 {
+		return ""
+	}
+
+	wrapped := words[0]
+	spaceLeft := width - DisplayWidth(wrapped)
+	for _, word := range words[1:] {
+		wordWidth := DisplayWidth(word)
+		if wordWidth+1 > spaceLeft {
+			wrapped += "\n" + word
+			spaceLeft = width - wordWidth
+		} else {
+			wrapped += " " + word
+			spaceLeft -= 1 + wordWidth
+		}
+	}
+
+	return wrapped
+}
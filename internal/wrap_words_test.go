@@ -0,0 +1,25 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm/internal"
+)
+
+func TestWrapWords(t *testing.T) {
+	testza.AssertEqual(t, "Hello World", internal.WrapWords("Hello World", 11))
+	testza.AssertEqual(t, "Hello\nWorld", internal.WrapWords("Hello World", 10))
+	testza.AssertEqual(t, "Hel\nlo", internal.WrapWords("Hello", 3))
+	testza.AssertEqual(t, "你好\n世界", internal.WrapWords("你好 世界", 5))
+	testza.AssertEqual(t, "\x1b[31mHello\x1b[0m\nWorld", internal.WrapWords("\x1b[31mHello\x1b[0m World", 6))
+	testza.AssertEqual(t, "", internal.WrapWords("   ", 6))
+}
+
+func TestWrapWordsIgnoresHyperlinks(t *testing.T) {
+	link := "\x1b]8;;https://example.com/a/very/long/path\x1b\\Hello\x1b]8;;\x1b\\"
+
+	testza.AssertEqual(t, link+" World", internal.WrapWords(link+" World", 11))
+	testza.AssertEqual(t, link+"\nWorld", internal.WrapWords(link+" World", 10))
+}
@@ -0,0 +1,81 @@
+package pterm
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/gookit/color"
+)
+
+// ProgressbarEvent is a JSON event, which is written by a ProgressbarPrinter with JSON output.
+// Type is "progress" for every update, "done" if the progressbar was completed, and "stopped" if it was stopped before.
+type ProgressbarEvent struct {
+	Type       string  `json:"type"`
+	Title      string  `json:"title"`
+	Current    int     `json:"current"`
+	Total      int     `json:"total"`
+	Percentage float64 `json:"percentage"`
+	// Elapsed is the elapsed time in seconds.
+	Elapsed float64 `json:"elapsed"`
+}
+
+// JSONProgressWriter writes the progress of a ProgressbarPrinter as JSON lines, instead of drawing the bar.
+// A ProgressbarPrinter with a JSONProgressWriter as Writer writes a ProgressbarEvent per update.
+// Everything else, which is written to it, is written as a message event, like {"type":"message","text":"..."}, without colors.
+//
+// Example:
+//
+//	bar, _ := pterm.DefaultProgressbar.WithWriter(pterm.NewJSONProgressWriter(os.Stdout)).Start()
+type JSONProgressWriter struct {
+	Writer io.Writer
+
+	mu sync.Mutex
+}
+
+// jsonMessageEvent is written for text, which is written to a JSONProgressWriter.
+type jsonMessageEvent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// NewJSONProgressWriter returns a new JSONProgressWriter, which writes to w.
+func NewJSONProgressWriter(w io.Writer) *JSONProgressWriter {
+	return &JSONProgressWriter{Writer: w}
+}
+
+// Write writes p as a message event. Colors and trailing newlines are removed.
+func (w *JSONProgressWriter) Write(p []byte) (int, error) {
+	// RemoveColorFromString can't be used, because pterm holds its lock while writing
+	text := strings.Trim(hyperlinkRegex.ReplaceAllString(color.ClearCode(string(p)), ""), "\r\n")
+	if text == "" {
+		return len(p), nil
+	}
+	if err := w.writeJSON(jsonMessageEvent{Type: "message", Text: text}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// WriteEvent writes a ProgressbarEvent as a single JSON line.
+func (w *JSONProgressWriter) WriteEvent(event ProgressbarEvent) error {
+	return w.writeJSON(event)
+}
+
+func (w *JSONProgressWriter) writeJSON(v interface{}) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return writeJSONLine(w.Writer, v)
+}
+
+// writeJSONLine writes v as a JSON line to w.
+func writeJSONLine(w io.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
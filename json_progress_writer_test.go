@@ -0,0 +1,89 @@
+package pterm_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func decodeProgressbarEvents(t *testing.T, s string) []pterm.ProgressbarEvent {
+	var events []pterm.ProgressbarEvent
+	for _, line := range strings.Split(strings.TrimSuffix(s, "\n"), "\n") {
+		var event pterm.ProgressbarEvent
+		testza.AssertNoError(t, json.Unmarshal([]byte(line), &event), line)
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestJSONProgressWriter_Progressbar(t *testing.T) {
+	var buf bytes.Buffer
+	p, err := pterm.DefaultProgressbar.WithTotal(2).WithTitle("Downloading").WithWriter(pterm.NewJSONProgressWriter(&buf)).Start()
+	testza.AssertNoError(t, err)
+	p.Increment()
+	p.Increment()
+
+	testza.AssertNotContains(t, buf.String(), "\x1b")
+	events := decodeProgressbarEvents(t, buf.String())
+	testza.AssertLen(t, events, 4)
+	testza.AssertEqual(t, "progress", events[0].Type)
+	testza.AssertEqual(t, "Downloading", events[0].Title)
+	testza.AssertEqual(t, 0, events[0].Current)
+	testza.AssertEqual(t, 2, events[0].Total)
+	testza.AssertEqual(t, 50.0, events[1].Percentage)
+	testza.AssertEqual(t, 2, events[2].Current)
+	testza.AssertEqual(t, "done", events[3].Type)
+}
+
+func TestJSONProgressWriter_Stopped(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithWriter(pterm.NewJSONProgressWriter(&buf)).Start()
+	p.Add(3)
+	p.Stop()
+	p.Stop()
+
+	events := decodeProgressbarEvents(t, buf.String())
+	testza.AssertLen(t, events, 3)
+	testza.AssertEqual(t, "stopped", events[2].Type)
+	testza.AssertEqual(t, 3, events[2].Current)
+}
+
+func TestJSONProgressWriter_Message(t *testing.T) {
+	var buf bytes.Buffer
+	w := pterm.NewJSONProgressWriter(&buf)
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithWriter(w).Start()
+	pterm.Info.WithWriter(w).Println("Hello, World!")
+	p.Stop()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	testza.AssertLen(t, lines, 3)
+	testza.AssertEqual(t, `{"type":"message","text":" INFO  Hello, World!"}`, lines[1])
+}
+
+func TestProgressbarPrinter_WithJSONOutput(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithJSONOutput()
+	testza.AssertTrue(t, p.JSONOutput)
+	testza.AssertFalse(t, pterm.DefaultProgressbar.JSONOutput)
+
+	var buf bytes.Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(1).WithJSONOutput().WithIndeterminate().WithWriter(&buf).Start()
+	bar.Stop()
+
+	events := decodeProgressbarEvents(t, buf.String())
+	testza.AssertLen(t, events, 2)
+	testza.AssertEqual(t, "progress", events[0].Type)
+	testza.AssertEqual(t, 0.0, events[0].Percentage)
+	testza.AssertEqual(t, "stopped", events[1].Type)
+}
+
+func TestJSONProgressWriter_WriteError(t *testing.T) {
+	p, err := pterm.DefaultProgressbar.WithTotal(10).WithWriter(pterm.NewJSONProgressWriter(failingWriter{})).Start()
+	testza.AssertNotNil(t, err)
+	_, err = p.Stop()
+	testza.AssertNotNil(t, err)
+}
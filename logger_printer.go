@@ -0,0 +1,249 @@
+package pterm
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pterm/pterm/internal"
+)
+
+// DefaultLogger is the default LoggerPrinter.
+var DefaultLogger = LoggerPrinter{
+	DebugPrinter:   &Debug,
+	InfoPrinter:    &Info,
+	SuccessPrinter: &Success,
+	WarningPrinter: &Warning,
+	ErrorPrinter:   &Error,
+	KeyStyle:       &ThemeDefault.ScopeStyle,
+	ValueStyle:     &ThemeDefault.DefaultText,
+	NumberStyle:    &ThemeDefault.PrimaryStyle,
+	BoolStyle:      &ThemeDefault.SecondaryStyle,
+}
+
+// LoggerPrinter prints messages with structured key-value fields.
+// The prefixes of the levels are padded to the widest one, so that all messages start in the same column,
+// and the fields are aligned in columns across consecutive lines printed by the same LoggerPrinter.
+// Without a Writer, every line is written to the Writer of its level printer.
+//
+// Example:
+//
+//	logger := pterm.DefaultLogger.WithWriter(os.Stderr)
+//	logger.Info("Connected", "host", "localhost", "port", 8080)
+//	logger.Warning("Slow response", "host", "localhost", "ms", 1200)
+type LoggerPrinter struct {
+	DebugPrinter   *PrefixPrinter
+	InfoPrinter    *PrefixPrinter
+	SuccessPrinter *PrefixPrinter
+	WarningPrinter *PrefixPrinter
+	ErrorPrinter   *PrefixPrinter
+	KeyStyle       *Style
+	ValueStyle     *Style
+	NumberStyle    *Style
+	BoolStyle      *Style
+	Writer         io.Writer
+
+	alignment *loggerAlignment
+}
+
+// loggerAlignment stores the column widths of all lines printed by a LoggerPrinter.
+type loggerAlignment struct {
+	mu sync.Mutex
+	// prefixWidth is the display width of the widest prefix of the level printers, so that all messages start in the same column.
+	prefixWidth int
+	lineWidth   int
+	fieldWidths []int
+}
+
+// loggerAlignmentLock guards the initialization of the alignment of LoggerPrinters.
+var loggerAlignmentLock sync.Mutex
+
+// getAlignment returns the alignment of the LoggerPrinter. It is created on first use.
+func (p *LoggerPrinter) getAlignment() *loggerAlignment {
+	loggerAlignmentLock.Lock()
+	defer loggerAlignmentLock.Unlock()
+
+	if p.alignment == nil {
+		p.alignment = &loggerAlignment{}
+		for _, printer := range []*PrefixPrinter{p.DebugPrinter, p.InfoPrinter, p.SuccessPrinter, p.WarningPrinter, p.ErrorPrinter} {
+			if printer == nil {
+				continue
+			}
+			if w := internal.DisplayWidth(printer.prefixText()); w > p.alignment.prefixWidth {
+				p.alignment.prefixWidth = w
+			}
+		}
+	}
+	return p.alignment
+}
+
+// WithKeyStyle returns a new LoggerPrinter with a specific KeyStyle.
+func (p LoggerPrinter) WithKeyStyle(style *Style) *LoggerPrinter {
+	p.KeyStyle = style
+	return &p
+}
+
+// WithValueStyle returns a new LoggerPrinter with a specific ValueStyle.
+func (p LoggerPrinter) WithValueStyle(style *Style) *LoggerPrinter {
+	p.ValueStyle = style
+	return &p
+}
+
+// WithNumberStyle returns a new LoggerPrinter with a specific NumberStyle, which is used for numeric values.
+func (p LoggerPrinter) WithNumberStyle(style *Style) *LoggerPrinter {
+	p.NumberStyle = style
+	return &p
+}
+
+// WithBoolStyle returns a new LoggerPrinter with a specific BoolStyle, which is used for boolean values.
+func (p LoggerPrinter) WithBoolStyle(style *Style) *LoggerPrinter {
+	p.BoolStyle = style
+	return &p
+}
+
+// WithWriter sets the custom Writer.
+func (p LoggerPrinter) WithWriter(writer io.Writer) *LoggerPrinter {
+	p.Writer = writer
+	return &p
+}
+
+// Debug prints a debug message with key-value fields.
+// Like the Debug printer, it will only print if PrintDebugMessages is true.
+func (p *LoggerPrinter) Debug(msg string, args ...interface{}) {
+	p.print(p.DebugPrinter, msg, args...)
+}
+
+// Info prints an info message with key-value fields.
+// The args are alternating keys and values.
+func (p *LoggerPrinter) Info(msg string, args ...interface{}) {
+	p.print(p.InfoPrinter, msg, args...)
+}
+
+// Success prints a success message with key-value fields.
+func (p *LoggerPrinter) Success(msg string, args ...interface{}) {
+	p.print(p.SuccessPrinter, msg, args...)
+}
+
+// Warning prints a warning message with key-value fields.
+func (p *LoggerPrinter) Warning(msg string, args ...interface{}) {
+	p.print(p.WarningPrinter, msg, args...)
+}
+
+// Error prints an error message with key-value fields.
+func (p *LoggerPrinter) Error(msg string, args ...interface{}) {
+	p.print(p.ErrorPrinter, msg, args...)
+}
+
+// Sprint returns the line which would be printed by the given PrefixPrinter with key-value fields.
+// The returned line updates the column alignment of the LoggerPrinter.
+func (p *LoggerPrinter) Sprint(printer *PrefixPrinter, msg string, args ...interface{}) string {
+	if printer == nil {
+		printer = &Info
+	}
+	if printer.Debugger && !PrintDebugMessages.Load() {
+		return ""
+	}
+	alignment := p.getAlignment()
+	themeLock.RLock()
+	fields := p.formatFields(args)
+	themeLock.RUnlock()
+
+	if printer.PrefixWidth < alignment.prefixWidth {
+		printer = printer.WithPrefixWidth(alignment.prefixWidth)
+	}
+	line := strings.TrimSuffix(printer.Sprint(msg), "\n")
+	if len(fields) == 0 {
+		return line
+	}
+
+	alignment.mu.Lock()
+	defer alignment.mu.Unlock()
+
+	lineWidth := internal.DisplayWidth(line)
+	if lineWidth > alignment.lineWidth {
+		alignment.lineWidth = lineWidth
+	}
+	for i, field := range fields {
+		fieldWidth := internal.DisplayWidth(field)
+		if i >= len(alignment.fieldWidths) {
+			alignment.fieldWidths = append(alignment.fieldWidths, fieldWidth)
+		} else if fieldWidth > alignment.fieldWidths[i] {
+			alignment.fieldWidths[i] = fieldWidth
+		}
+	}
+
+	line += strings.Repeat(" ", alignment.lineWidth-lineWidth)
+	for i, field := range fields {
+		line += " " + field
+		// The last field does not need trailing spaces.
+		if i < len(fields)-1 {
+			line += strings.Repeat(" ", alignment.fieldWidths[i]-internal.DisplayWidth(field))
+		}
+	}
+
+	return line
+}
+
+func (p *LoggerPrinter) print(printer *PrefixPrinter, msg string, args ...interface{}) {
+	line := p.Sprint(printer, msg, args...)
+	if line == "" {
+		return
+	}
+	writer := p.Writer
+	if writer == nil {
+		if printer == nil {
+			printer = &Info
+		}
+		writer = printer.writer()
+	}
+	Fprintln(writer, line)
+}
+
+// formatFields returns the styled "key=value" pairs of alternating keys and values.
+// A key without a value is printed with an empty value.
+func (p LoggerPrinter) formatFields(args []interface{}) []string {
+	if p.KeyStyle == nil {
+		p.KeyStyle = NewStyle()
+	}
+
+	var fields []string
+	for i := 0; i < len(args); i += 2 {
+		key := fmt.Sprint(args[i])
+		var value string
+		if i+1 < len(args) {
+			value = p.formatValue(args[i+1])
+		}
+		if RawOutput.Load() {
+			fields = append(fields, key+"="+RemoveColorFromString(value))
+		} else {
+			fields = append(fields, p.KeyStyle.Sprint(key+"=")+value)
+		}
+	}
+
+	return fields
+}
+
+func (p LoggerPrinter) formatValue(value interface{}) string {
+	style := p.ValueStyle
+	var text string
+	switch v := value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		style = p.NumberStyle
+		text = fmt.Sprint(v)
+	case bool:
+		style = p.BoolStyle
+		text = strconv.FormatBool(v)
+	default:
+		text = fmt.Sprint(v)
+		if text == "" || strings.ContainsAny(text, " =\"") {
+			text = strconv.Quote(text)
+		}
+	}
+	if style == nil {
+		style = NewStyle()
+	}
+
+	return style.Sprint(text)
+}
@@ -0,0 +1,108 @@
+package pterm_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestLoggerPrinter_AlignsFields(t *testing.T) {
+	logger := pterm.DefaultLogger
+	first := pterm.RemoveColorFromString(logger.Sprint(&pterm.Info, "Started", "host", "localhost", "port", 8080))
+	second := pterm.RemoveColorFromString(logger.Sprint(&pterm.Info, "Connection established", "host", "db", "port", 5432))
+	third := pterm.RemoveColorFromString(logger.Sprint(&pterm.Info, "Stopped", "host", "localhost", "port", 1))
+
+	testza.AssertEqual(t, "  INFO    Started host=localhost port=8080", first)
+	testza.AssertEqual(t, strings.Index(second, "host="), strings.Index(third, "host="))
+	testza.AssertEqual(t, strings.Index(second, "port="), strings.Index(third, "port="))
+}
+
+func TestLoggerPrinter_AlignsMessagesOfDifferentLevels(t *testing.T) {
+	logger := pterm.DefaultLogger
+	info := pterm.RemoveColorFromString(logger.Sprint(logger.InfoPrinter, "Connected", "host", "localhost"))
+	warning := pterm.RemoveColorFromString(logger.Sprint(logger.WarningPrinter, "Connected", "host", "localhost"))
+
+	testza.AssertEqual(t, strings.Index(info, "Connected"), strings.Index(warning, "Connected"))
+	testza.AssertEqual(t, strings.Index(info, "host="), strings.Index(warning, "host="))
+}
+
+func TestLoggerPrinter_Concurrent(t *testing.T) {
+	logger := pterm.DefaultLogger.WithWriter(io.Discard)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			logger.Info("Hello", "i", i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestLoggerPrinter_UsesWriterOfLevelPrinter(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger
+	logger.InfoPrinter = pterm.Info.WithWriter(&buf)
+	logger.Info("Hello", "key", "value")
+	testza.AssertContains(t, buf.String(), "Hello")
+}
+
+func TestLoggerPrinter_ValueFormatting(t *testing.T) {
+	logger := pterm.DefaultLogger
+	s := pterm.RemoveColorFromString(logger.Sprint(&pterm.Info, "msg", "text", "hello world", "ok", true, "ratio", 0.5, "missing"))
+	testza.AssertContains(t, s, `text="hello world"`)
+	testza.AssertContains(t, s, "ok=true")
+	testza.AssertContains(t, s, "ratio=0.5")
+	testza.AssertContains(t, s, "missing=")
+}
+
+func TestLoggerPrinter_NumbersAndBoolsAreStyledDistinctly(t *testing.T) {
+	logger := pterm.DefaultLogger.WithNumberStyle(pterm.NewStyle(pterm.FgRed)).WithBoolStyle(pterm.NewStyle(pterm.FgBlue))
+	s := logger.Sprint(&pterm.Info, "msg", "n", 42, "b", false)
+	testza.AssertContains(t, s, pterm.FgRed.Sprint("42"))
+	testza.AssertContains(t, s, pterm.FgBlue.Sprint("false"))
+}
+
+func TestLoggerPrinter_WithWriter(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf)
+	logger.Info("Hello", "key", "value")
+	logger.Warning("World")
+	testza.AssertContains(t, buf.String(), "key=")
+	testza.AssertContains(t, buf.String(), "World")
+}
+
+func TestLoggerPrinter_DebugOnlyWithDebugMessages(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf)
+	pterm.DisableDebugMessages()
+	logger.Debug("Hidden", "key", "value")
+	testza.AssertEqual(t, "", buf.String())
+	pterm.EnableDebugMessages()
+	logger.Debug("Visible", "key", "value")
+	pterm.DisableDebugMessages()
+	testza.AssertContains(t, buf.String(), "Visible")
+}
+
+func TestLoggerPrinter_RawOutput(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+	logger := pterm.DefaultLogger
+	testza.AssertEqual(t, "INFO: msg key=value", logger.Sprint(&pterm.Info, "msg", "key", "value"))
+}
+
+func TestLoggerPrinter_WithKeyStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	testza.AssertEqual(t, s, pterm.DefaultLogger.WithKeyStyle(s).KeyStyle)
+}
+
+func TestLoggerPrinter_WithValueStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	testza.AssertEqual(t, s, pterm.DefaultLogger.WithValueStyle(s).ValueStyle)
+}
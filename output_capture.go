@@ -0,0 +1,41 @@
+package pterm
+
+import "strings"
+
+// outputCapture stores the output, which is written to the default output and the error output while capturing is enabled.
+// It's guarded by pLock.
+var outputCapture struct {
+	enabled bool
+	buffer  strings.Builder
+}
+
+// EnableOutputCapture starts capturing everything, which PTerm writes to the default output and the error output, in addition to printing it.
+// Printers with a custom Writer are not captured. Previously captured output is discarded.
+// Use DisableStyling before, to capture the output without colors.
+//
+// Example:
+//
+//	pterm.EnableOutputCapture()
+//	defer pterm.DisableOutputCapture()
+//	pterm.DefaultTable.WithData(data).Render()
+//	golden := pterm.GetCapturedOutput()
+func EnableOutputCapture() {
+	pLock.Lock()
+	defer pLock.Unlock()
+	outputCapture.enabled = true
+	outputCapture.buffer.Reset()
+}
+
+// DisableOutputCapture stops capturing the output. The captured output can still be read with GetCapturedOutput.
+func DisableOutputCapture() {
+	pLock.Lock()
+	defer pLock.Unlock()
+	outputCapture.enabled = false
+}
+
+// GetCapturedOutput returns the output, which was captured since EnableOutputCapture was called.
+func GetCapturedOutput() string {
+	pLock.RLock()
+	defer pLock.RUnlock()
+	return outputCapture.buffer.String()
+}
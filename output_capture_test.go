@@ -0,0 +1,45 @@
+package pterm_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+func TestOutputCapture(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	pterm.EnableOutputCapture()
+	pterm.Println("Hello, World!")
+	pterm.DefaultTable.WithData(pterm.TableData{{"a", "b"}, {"c", "d"}}).Render()
+	pterm.Info.Println("info")
+	pterm.DefaultTree.WithRoot(pterm.TreeNode{Text: "root", Children: []pterm.TreeNode{{Text: "child"}}}).Render()
+	pterm.DisableOutputCapture()
+	pterm.Println("not captured")
+
+	testza.AssertEqual(t, "Hello, World!\na | b\nc | d\nINFO: info\nroot\n└──child\n\n", pterm.GetCapturedOutput())
+}
+
+func TestOutputCapture_IgnoresCustomWriters(t *testing.T) {
+	var buf bytes.Buffer
+	pterm.EnableOutputCapture()
+	defer pterm.DisableOutputCapture()
+
+	pterm.Fprintln(&buf, "custom writer")
+
+	testza.AssertEqual(t, "", pterm.GetCapturedOutput())
+	testza.AssertContains(t, buf.String(), "custom writer")
+}
+
+func TestOutputCapture_ResetsOnEnable(t *testing.T) {
+	pterm.EnableOutputCapture()
+	pterm.Print("first")
+	pterm.EnableOutputCapture()
+	pterm.Print("second")
+	pterm.DisableOutputCapture()
+
+	testza.AssertEqual(t, "second", pterm.GetCapturedOutput())
+}
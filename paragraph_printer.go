@@ -3,7 +3,8 @@ package pterm
 import (
 	"fmt"
 	"io"
-	"strings"
+
+	"github.com/pterm/pterm/internal"
 )
 
 // DefaultParagraph contains the default values for a ParagraphPrinter.
@@ -13,13 +14,15 @@ var DefaultParagraph = ParagraphPrinter{
 
 // ParagraphPrinter can print paragraphs to a fixed line width.
 // The text will split between words, so that words will stick together.
+// Words, which are longer than the line width, will be split.
 // It's like in a book.
 type ParagraphPrinter struct {
 	MaxWidth int
 	Writer   io.Writer
 }
 
-// WithMaxWidth returns a new ParagraphPrinter with a specific MaxWidth
+// WithMaxWidth returns a new ParagraphPrinter with a specific MaxWidth.
+// If the width is zero, or below, the terminal width will be used.
 func (p ParagraphPrinter) WithMaxWidth(width int) *ParagraphPrinter {
 	p.MaxWidth = width
 	return &p
@@ -38,23 +41,15 @@ func (p ParagraphPrinter) Sprint(a ...interface{}) string {
 		return Sprint(a...)
 	}
 
-	words := strings.Fields(strings.TrimSpace(Sprint(a...)))
-	if len(words) == 0 {
-		return ""
+	maxWidth := p.MaxWidth
+	if maxWidth <= 0 {
+		maxWidth = GetTerminalWidth()
 	}
-	wrapped := words[0]
-	spaceLeft := p.MaxWidth - len(wrapped)
-	for _, word := range words[1:] {
-		if len(word)+1 > spaceLeft {
-			wrapped += "\n" + word
-			spaceLeft = p.MaxWidth - len(word)
-		} else {
-			wrapped += " " + word
-			spaceLeft -= 1 + len(word)
-		}
+	if maxWidth <= 0 {
+		maxWidth = FallbackTerminalWidth
 	}
 
-	return wrapped
+	return internal.WrapWords(Sprint(a...), maxWidth)
 }
 
 // Sprintln formats using the default formats for its operands and returns the resulting string.
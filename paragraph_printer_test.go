@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/MarvinJWendt/testza"
@@ -121,3 +122,25 @@ func TestParagraphPrinter_WithWriter(t *testing.T) {
 	testza.AssertEqual(t, s, p2.Writer)
 	testza.AssertZero(t, p.Writer)
 }
+
+func TestParagraphPrinter_SprintWrapsToMaxWidth(t *testing.T) {
+	p := pterm.DefaultParagraph.WithMaxWidth(10)
+
+	testza.AssertEqual(t, "Lorem\nipsum\ndolor sit\namet", p.Sprint("Lorem ipsum dolor sit amet"))
+}
+
+func TestParagraphPrinter_SprintBreaksLongWords(t *testing.T) {
+	p := pterm.DefaultParagraph.WithMaxWidth(5)
+
+	testza.AssertEqual(t, "a\nabcde\nfghij\nk", p.Sprint("a abcdefghijk"))
+}
+
+func TestParagraphPrinter_SprintWithoutMaxWidth(t *testing.T) {
+	p := pterm.ParagraphPrinter{}
+	s := p.Sprint(strings.Repeat("word ", 30))
+
+	for _, line := range strings.Split(s, "\n") {
+		testza.AssertTrue(t, len(line) <= pterm.GetTerminalWidth())
+	}
+	testza.AssertTrue(t, strings.Count(s, "\n") > 0)
+}
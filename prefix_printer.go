@@ -5,6 +5,9 @@ import (
 	"io"
 	"runtime"
 	"strings"
+	"time"
+
+	"github.com/mattn/go-runewidth"
 
 	"github.com/pterm/pterm/internal"
 )
@@ -31,6 +34,7 @@ var (
 			Style: &ThemeDefault.WarningPrefixStyle,
 			Text:  "WARNING",
 		},
+		errorLevel: true,
 	}
 
 	// Success returns a PrefixPrinter, which can be used to print text with a "success" Prefix.
@@ -49,6 +53,7 @@ var (
 			Style: &ThemeDefault.ErrorPrefixStyle,
 			Text:  " ERROR ",
 		},
+		errorLevel: true,
 	}
 
 	// Fatal returns a PrefixPrinter, which can be used to print text with an "fatal" Prefix.
@@ -59,7 +64,8 @@ var (
 			Style: &ThemeDefault.FatalPrefixStyle,
 			Text:  " FATAL ",
 		},
-		Fatal: true,
+		Fatal:      true,
+		errorLevel: true,
 	}
 
 	// Debug Prints debug messages. By default it will only print if PrintDebugMessages is true.
@@ -92,9 +98,20 @@ type PrefixPrinter struct {
 	ShowLineNumber   bool
 	LineNumberOffset int
 	Writer           io.Writer
+	// If ShowTimestamp is true, every message starts with the current time, formatted with TimeFormat.
+	ShowTimestamp bool
+	// TimeFormat is the layout of the timestamp, as used by time.Format. If it is empty, "15:04:05" is used.
+	TimeFormat string
+	// TimestampStyle is the style of the timestamp. If it is nil, the TimestampStyle of ThemeDefault is used.
+	TimestampStyle *Style
 	// If Debugger is true, the printer will only print if PrintDebugMessages is set to true.
 	// You can change PrintDebugMessages with EnableDebugMessages and DisableDebugMessages, or by setting the variable itself.
 	Debugger bool
+	// PrefixWidth is the minimum width of the Prefix text. Shorter texts are centered, so that multiple PrefixPrinters align.
+	PrefixWidth int
+
+	// errorLevel is true for the Error, Warning and Fatal printers, which write to the error output (see SetErrorWriter).
+	errorLevel bool
 }
 
 // WithPrefix adds a custom prefix to the printer.
@@ -103,6 +120,19 @@ func (p PrefixPrinter) WithPrefix(prefix Prefix) *PrefixPrinter {
 	return &p
 }
 
+// WithIcon replaces the text of the Prefix with an icon, like an emoji, and keeps the style of the Prefix.
+func (p PrefixPrinter) WithIcon(icon string) *PrefixPrinter {
+	p.Prefix.Text = icon
+	return &p
+}
+
+// WithPrefixWidth sets the minimum width of the Prefix text.
+// Shorter texts are centered, so that the messages of multiple PrefixPrinters start in the same column.
+func (p PrefixPrinter) WithPrefixWidth(width int) *PrefixPrinter {
+	p.PrefixWidth = width
+	return &p
+}
+
 // WithScope adds a scope to the Prefix.
 func (p PrefixPrinter) WithScope(scope Scope) *PrefixPrinter {
 	p.Scope = scope
@@ -146,7 +176,27 @@ func (p PrefixPrinter) WithLineNumberOffset(offset int) *PrefixPrinter {
 	return &p
 }
 
+// WithTimestamp sets if every message should start with the current time.
+func (p PrefixPrinter) WithTimestamp(b ...bool) *PrefixPrinter {
+	p.ShowTimestamp = internal.WithBoolean(b)
+	return &p
+}
+
+// WithTimeFormat sets the layout of the timestamp, as used by time.Format.
+func (p PrefixPrinter) WithTimeFormat(layout string) *PrefixPrinter {
+	p.TimeFormat = layout
+	return &p
+}
+
+// WithTimestampStyle sets the style of the timestamp.
+// Use NewStyle() to print the timestamp without colors.
+func (p PrefixPrinter) WithTimestampStyle(style *Style) *PrefixPrinter {
+	p.TimestampStyle = style
+	return &p
+}
+
 // WithWriter sets the custom Writer.
+// If no Writer is set, the default output of PTerm is used (see SetDefaultOutput).
 func (p PrefixPrinter) WithWriter(writer io.Writer) *PrefixPrinter {
 	p.Writer = writer
 	return &p
@@ -154,17 +204,25 @@ func (p PrefixPrinter) WithWriter(writer io.Writer) *PrefixPrinter {
 
 // Sprint formats using the default formats for its operands and returns the resulting string.
 // Spaces are added between operands when neither is a string.
-func (p *PrefixPrinter) Sprint(a ...interface{}) string {
+func (p PrefixPrinter) Sprint(a ...interface{}) string {
 	m := Sprint(a...)
 	if p.Debugger && !PrintDebugMessages.Load() {
 		return ""
 	}
 
+	var timestamp string
+	if p.ShowTimestamp {
+		timestamp = p.timestamp()
+	}
+
 	if RawOutput.Load() {
+		if timestamp != "" {
+			timestamp += " "
+		}
 		if p.Prefix.Text != "" {
-			return Sprintf("%s: %s", strings.TrimSpace(p.Prefix.Text), Sprint(a...))
+			return Sprintf("%s%s: %s", timestamp, strings.TrimSpace(p.Prefix.Text), Sprint(a...))
 		} else {
-			return Sprint(a...)
+			return timestamp + Sprint(a...)
 		}
 	}
 
@@ -177,6 +235,12 @@ func (p *PrefixPrinter) Sprint(a ...interface{}) string {
 	if p.MessageStyle == nil {
 		p.MessageStyle = NewStyle()
 	}
+	if p.TimestampStyle == nil {
+		p.TimestampStyle = &ThemeDefault.TimestampStyle
+	}
+
+	themeLock.RLock()
+	defer themeLock.RUnlock()
 
 	var ret string
 	var newLine bool
@@ -189,18 +253,25 @@ func (p *PrefixPrinter) Sprint(a ...interface{}) string {
 	messageLines := strings.Split(m, "\n")
 	for i, m := range messageLines {
 		if i == 0 {
-			ret += p.GetFormattedPrefix() + " "
+			if timestamp != "" {
+				ret += p.TimestampStyle.Sprint(timestamp) + " "
+			}
+			ret += p.formattedPrefix() + " "
 			if p.Scope.Text != "" {
 				ret += NewStyle(*p.Scope.Style...).Sprint(" (" + p.Scope.Text + ") ")
 			}
 			ret += p.MessageStyle.Sprint(m)
 		} else {
-			ret += "\n" + p.Prefix.Style.Sprint(strings.Repeat(" ", len(p.Prefix.Text)+2)) + " " + p.MessageStyle.Sprint(m)
+			ret += "\n"
+			if timestamp != "" {
+				ret += strings.Repeat(" ", runewidth.StringWidth(timestamp)+1)
+			}
+			ret += p.Prefix.Style.Sprint(strings.Repeat(" ", internal.DisplayWidth(p.prefixText())+2)) + " " + p.MessageStyle.Sprint(m)
 		}
 	}
 
 	if p.ShowLineNumber {
-		_, fileName, line, _ := runtime.Caller(3 + p.LineNumberOffset)
+		fileName, line := p.caller()
 		ret += FgGray.Sprint("\n└ " + fmt.Sprintf("(%s:%d)\n", fileName, line))
 		newLine = false
 	}
@@ -247,9 +318,7 @@ func (p *PrefixPrinter) Print(a ...interface{}) *TextPrinter {
 	if p.Debugger && !PrintDebugMessages.Load() {
 		return &tp
 	}
-	p.LineNumberOffset--
-	Fprint(p.Writer, p.Sprint(a...))
-	p.LineNumberOffset++
+	Fprint(p.writer(), p.Sprint(a...))
 	checkFatal(p)
 	return &tp
 }
@@ -262,7 +331,7 @@ func (p *PrefixPrinter) Println(a ...interface{}) *TextPrinter {
 	if p.Debugger && !PrintDebugMessages.Load() {
 		return &tp
 	}
-	Fprint(p.Writer, p.Sprintln(a...))
+	Fprint(p.writer(), p.Sprintln(a...))
 	checkFatal(p)
 	return &tp
 }
@@ -274,7 +343,7 @@ func (p *PrefixPrinter) Printf(format string, a ...interface{}) *TextPrinter {
 	if p.Debugger && !PrintDebugMessages.Load() {
 		return &tp
 	}
-	Fprint(p.Writer, p.Sprintf(format, a...))
+	Fprint(p.writer(), p.Sprintf(format, a...))
 	checkFatal(p)
 	return &tp
 }
@@ -287,9 +356,7 @@ func (p *PrefixPrinter) Printfln(format string, a ...interface{}) *TextPrinter {
 	if p.Debugger && !PrintDebugMessages.Load() {
 		return &tp
 	}
-	p.LineNumberOffset++
-	Fprint(p.Writer, p.Sprintfln(format, a...))
-	p.LineNumberOffset--
+	Fprint(p.writer(), p.Sprintfln(format, a...))
 	checkFatal(p)
 	return &tp
 }
@@ -328,9 +395,64 @@ func (p *PrefixPrinter) PrintOnErrorf(format string, a ...interface{}) *TextPrin
 	return &tp
 }
 
+// caller returns the file and line of the first caller outside of the PrefixPrinter methods.
+// LineNumberOffset additional calls are skipped.
+func (p PrefixPrinter) caller() (string, int) {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	skip := p.LineNumberOffset
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "github.com/pterm/pterm.PrefixPrinter.") &&
+			!strings.HasPrefix(frame.Function, "github.com/pterm/pterm.(*PrefixPrinter).") {
+			if skip <= 0 || !more {
+				return frame.File, frame.Line
+			}
+			skip--
+		}
+		if !more {
+			return frame.File, frame.Line
+		}
+	}
+}
+
+// timestamp returns the current time, formatted with the TimeFormat.
+func (p PrefixPrinter) timestamp() string {
+	layout := p.TimeFormat
+	if layout == "" {
+		layout = "15:04:05"
+	}
+	return time.Now().Format(layout)
+}
+
+// writer returns the Writer of the PrefixPrinter.
+// The Error, Warning and Fatal printers use the error output, if no Writer is set.
+func (p PrefixPrinter) writer() io.Writer {
+	if p.Writer != nil || !p.errorLevel {
+		return p.Writer
+	}
+	return errorOutputWriter{}
+}
+
 // GetFormattedPrefix returns the Prefix as a styled text string.
 func (p PrefixPrinter) GetFormattedPrefix() string {
-	return p.Prefix.Style.Sprint(" " + p.Prefix.Text + " ")
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+	return p.formattedPrefix()
+}
+
+// formattedPrefix returns the Prefix as a styled text string. The caller has to hold themeLock for reading.
+func (p PrefixPrinter) formattedPrefix() string {
+	return p.Prefix.Style.Sprint(" " + p.prefixText() + " ")
+}
+
+// prefixText returns the text of the Prefix, centered to the PrefixWidth.
+func (p PrefixPrinter) prefixText() string {
+	padding := p.PrefixWidth - internal.DisplayWidth(p.Prefix.Text)
+	if padding <= 0 {
+		return p.Prefix.Text
+	}
+	return strings.Repeat(" ", padding/2) + p.Prefix.Text + strings.Repeat(" ", padding-padding/2)
 }
 
 // Prefix contains the data used as the beginning of a printed text via a PrefixPrinter.
@@ -1,11 +1,17 @@
 package pterm_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/MarvinJWendt/testza"
 
@@ -202,6 +208,34 @@ func TestPrefixPrinter_WithPrefix(t *testing.T) {
 	}
 }
 
+func TestPrefixPrinter_WithIcon(t *testing.T) {
+	for _, p := range prefixPrinters {
+		t.Run("", func(t *testing.T) {
+			p2 := p.WithIcon("🔍")
+
+			testza.AssertEqual(t, "🔍", p2.Prefix.Text)
+			testza.AssertEqual(t, p.Prefix.Style, p2.Prefix.Style)
+		})
+	}
+}
+
+func TestPrefixPrinter_WithPrefixWidth(t *testing.T) {
+	p := pterm.Info.WithPrefixWidth(9)
+	testza.AssertEqual(t, 9, p.PrefixWidth)
+	testza.AssertZero(t, pterm.Info.PrefixWidth)
+}
+
+func TestPrefixPrinter_PrefixWidthAlignsMessages(t *testing.T) {
+	scan := pterm.Info.WithPrefix(pterm.Prefix{Text: "scan", Style: pterm.NewStyle()}).WithPrefixWidth(7)
+	icon := pterm.Info.WithIcon("🔍").WithPrefixWidth(7)
+
+	testza.AssertEqual(t, "  scan    hello\n          world", pterm.RemoveColorFromString(scan.Sprint("hello\nworld")))
+	testza.AssertEqual(t, "   🔍     hello", pterm.RemoveColorFromString(icon.Sprint("hello")))
+	testza.AssertEqual(t, pterm.RemoveColorFromString(pterm.Warning.Sprint("hello")), " WARNING  hello")
+	// texts, which are wider than the PrefixWidth, are not changed
+	testza.AssertEqual(t, " WARNING  hello", pterm.RemoveColorFromString(pterm.Warning.WithPrefixWidth(3).Sprint("hello")))
+}
+
 func TestPrefixPrinter_WithScope(t *testing.T) {
 	for _, p := range prefixPrinters {
 		t.Run("", func(t *testing.T) {
@@ -425,3 +459,165 @@ func TestPrefixPrinter_WithWriter(t *testing.T) {
 		})
 	}
 }
+
+func TestPrefixPrinter_SprintOnValue(t *testing.T) {
+	printers := map[string]pterm.PrefixPrinter{"info": pterm.Info, "error": pterm.Error}
+	s := printers["error"].Sprint("Hello, World!")
+	testza.AssertEqual(t, pterm.Error.Sprint("Hello, World!"), s)
+	testza.AssertContains(t, pterm.RemoveColorFromString(s), "ERROR")
+	testza.AssertContains(t, s, "Hello, World!")
+	testza.AssertEqual(t, "", pterm.PrefixPrinter{Debugger: true}.Sprint("hidden"))
+}
+
+func TestPrefixPrinter_WithWriterDoesNotPrintToDefaultOutput(t *testing.T) {
+	var buf bytes.Buffer
+	out := captureStdout(func(w io.Writer) {
+		pterm.Success.WithWriter(&buf).Println("Hello, World!")
+	})
+	testza.AssertEqual(t, "", out)
+	testza.AssertEqual(t, pterm.Success.Sprintln("Hello, World!"), buf.String())
+}
+
+func TestPrefixPrinter_ShowLineNumberPointsToCaller(t *testing.T) {
+	var buf bytes.Buffer
+	var tp pterm.TextPrinter = pterm.Info.WithShowLineNumber().WithWriter(&buf)
+	tp.Println("Hello, World!")
+	testza.AssertContains(t, buf.String(), "prefix_printer_test.go")
+	s := pterm.Info.WithShowLineNumber().Sprint("Hello, World!")
+	testza.AssertContains(t, s, "prefix_printer_test.go")
+
+	buf.Reset()
+	pterm.Info.WithShowLineNumber().WithWriter(&buf).Printfln("%s", "Hello, World!")
+	testza.AssertContains(t, buf.String(), "prefix_printer_test.go")
+}
+
+func TestPrefixPrinter_LineNumberOffsetSkipsWrapper(t *testing.T) {
+	var buf bytes.Buffer
+	wrapper := func() {
+		pterm.Info.WithShowLineNumber().WithLineNumberOffset(1).WithWriter(&buf).Println("Hello, World!")
+	}
+	wrapper()
+	_, _, line, _ := runtime.Caller(0)
+	testza.AssertContains(t, buf.String(), fmt.Sprintf("prefix_printer_test.go:%d)", line-1))
+}
+
+func TestPrefixPrinter_WithTimestamp(t *testing.T) {
+	for _, p := range prefixPrinters {
+		t.Run("", func(t *testing.T) {
+			p2 := p.WithTimestamp()
+
+			testza.AssertTrue(t, p2.ShowTimestamp)
+			testza.AssertFalse(t, p.ShowTimestamp)
+		})
+	}
+}
+
+func TestPrefixPrinter_WithTimeFormat(t *testing.T) {
+	p := pterm.Info.WithTimeFormat(time.RFC3339)
+	testza.AssertEqual(t, time.RFC3339, p.TimeFormat)
+}
+
+func TestPrefixPrinter_WithTimestampStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.Info.WithTimestampStyle(s)
+	testza.AssertEqual(t, s, p.TimestampStyle)
+}
+
+func TestPrefixPrinter_SprintWithTimestamp(t *testing.T) {
+	s := pterm.RemoveColorFromString(pterm.Info.WithTimestamp().Sprint("Hello\nWorld"))
+	testza.AssertTrue(t, regexp.MustCompile(`^\d\d:\d\d:\d\d  INFO  Hello\n {9}\s{6} World$`).MatchString(s), s)
+
+	year := strconv.Itoa(time.Now().Year())
+	s = pterm.Warning.WithTimestamp().WithTimeFormat("2006").WithTimestampStyle(pterm.NewStyle()).Sprint("Hello")
+	testza.AssertTrue(t, strings.HasPrefix(s, year+" "), s)
+}
+
+func TestPrefixPrinter_SprintWithTimestampRawOutput(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	year := strconv.Itoa(time.Now().Year())
+	testza.AssertEqual(t, year+" INFO: Hello", pterm.Info.WithTimestamp().WithTimeFormat("2006").Sprint("Hello"))
+	testza.AssertEqual(t, "INFO: Hello", pterm.Info.Sprint("Hello"))
+}
+
+func TestPrefixPrinter_WithoutTimestampIsUnchanged(t *testing.T) {
+	testza.AssertEqual(t, pterm.Info.Sprint("Hello"), pterm.Info.WithTimestamp(false).WithTimeFormat("2006").Sprint("Hello"))
+}
+
+func TestSetErrorWriter(t *testing.T) {
+	defer setupStdoutCapture()
+
+	var stdout, stderr bytes.Buffer
+	pterm.SetDefaultOutput(&stdout)
+	pterm.SetErrorWriter(&stderr)
+
+	pterm.Info.Println("info")
+	pterm.Success.Println("success")
+	pterm.Error.Println("error")
+	pterm.Warning.WithScope(pterm.Scope{Text: "scope"}).Println("warning")
+	testza.AssertContains(t, stdout.String(), "info")
+	testza.AssertContains(t, stdout.String(), "success")
+	testza.AssertNotContains(t, stdout.String(), "error")
+	testza.AssertContains(t, stderr.String(), "error")
+	testza.AssertContains(t, stderr.String(), "warning")
+
+	// a custom Writer is always used
+	var custom bytes.Buffer
+	pterm.Error.WithWriter(&custom).Println("custom")
+	testza.AssertContains(t, custom.String(), "custom")
+	testza.AssertNotContains(t, stderr.String(), "custom")
+
+	// without an error writer, the default output is used
+	pterm.SetErrorWriter(nil)
+	pterm.Error.Println("compatible")
+	testza.AssertContains(t, stdout.String(), "compatible")
+}
+
+func TestSetErrorWriter_Captured(t *testing.T) {
+	defer setupStdoutCapture()
+
+	var stderr bytes.Buffer
+	pterm.SetErrorWriter(&stderr)
+	pterm.EnableOutputCapture()
+	defer pterm.DisableOutputCapture()
+
+	pterm.Error.Println("error")
+	pterm.Error.WithWriter(&bytes.Buffer{}).Println("custom")
+	testza.AssertContains(t, stderr.String(), "error")
+	testza.AssertContains(t, pterm.GetCapturedOutput(), "error")
+	testza.AssertNotContains(t, pterm.GetCapturedOutput(), "custom")
+}
+
+func TestSetErrorWriter_AboveProgressbarOnTerminal(t *testing.T) {
+	defer setupStdoutCapture()
+	pterm.SetForceTTY(true)
+	defer pterm.SetForceTTY(false)
+
+	var stdout, stderr Buffer
+	pterm.SetDefaultOutput(&stdout)
+	pterm.SetErrorWriter(&stderr)
+
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithShowElapsedTime(false).Start("Bar")
+	rendered := strings.Count(stdout.String(), "Bar")
+	pterm.Error.Println("error")
+	bar.Stop()
+
+	// the line of the progressbar is cleared, and the progressbar is rendered again below the message
+	testza.AssertTrue(t, strings.HasPrefix(stderr.String(), "\r"+strings.Repeat(" ", pterm.GetTerminalWidth())))
+	testza.AssertTrue(t, strings.Count(stdout.String(), "Bar") > rendered)
+}
+
+func TestSetErrorWriter_NotAboveProgressbarWithoutTerminal(t *testing.T) {
+	defer setupStdoutCapture()
+
+	var stdout, stderr Buffer
+	pterm.SetDefaultOutput(&stdout)
+	pterm.SetErrorWriter(&stderr)
+
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithShowElapsedTime(false).Start("Bar")
+	pterm.Error.Println("error")
+	bar.Stop()
+
+	testza.AssertFalse(t, strings.HasPrefix(stderr.String(), "\r"))
+}
@@ -3,23 +3,99 @@ package pterm
 import (
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"sync"
 
 	"github.com/gookit/color"
+	"golang.org/x/term"
 )
 
 // Need to use this because "github.com/gookit/color" is NOT a thread-safe library for Print & Sprintf functions.
 // Used to protect against some unsafe actions in Fprint as well
 var pLock sync.RWMutex
 
+// defaultOutput is the writer, which is used if no custom Writer is set. It's guarded by pLock.
+var defaultOutput io.Writer = os.Stdout
+
 // SetDefaultOutput sets the default output of pterm.
+// Every printer without a custom Writer, including the live printers like the progressbar and the spinner, writes to this output.
+// Only the Error, Warning and Fatal printers write to the error output instead, unless it was disabled with SetErrorWriter(nil).
+// Use NewTeeWriter to write to multiple outputs at once.
 func SetDefaultOutput(w io.Writer) {
 	pLock.Lock()
 	defer pLock.Unlock()
+	defaultOutput = w
 	color.SetOutput(w)
 }
 
+// errorOutput is the writer, which is used by the Error, Warning and Fatal printers, if they have no custom Writer.
+// If it is nil, the default output is used. It's guarded by pLock.
+var errorOutput io.Writer = os.Stderr
+
+// SetErrorWriter sets the output of the Error, Warning and Fatal printers, if they have no custom Writer.
+// It is os.Stderr by default, so that error output can be redirected separately.
+// Use SetErrorWriter(nil) to write them to the default output, like every other printer.
+func SetErrorWriter(w io.Writer) {
+	pLock.Lock()
+	defer pLock.Unlock()
+	errorOutput = w
+}
+
+// errorOutputWriter is the Writer of the Error, Warning and Fatal printers, if they have no custom Writer.
+// It is resolved to the error output, when it is written to, so that the output is captured
+// and live printers on the default output are rendered below it.
+type errorOutputWriter struct{}
+
+// Write writes b to the error output.
+func (errorOutputWriter) Write(b []byte) (int, error) {
+	pLock.Lock()
+	defer pLock.Unlock()
+	w, _ := resolveWriter(errorOutputWriter{})
+	return w.Write(b)
+}
+
+// resolveWriter returns the writer, which is used for w, and if the output is captured.
+// Nil stands for the default output, and errorOutputWriter for the error output.
+// The caller has to hold pLock.
+func resolveWriter(w io.Writer) (io.Writer, bool) {
+	switch w.(type) {
+	case nil:
+		return defaultOutput, true
+	case errorOutputWriter:
+		if errorOutput == nil {
+			return defaultOutput, true
+		}
+		return errorOutput, true
+	}
+	return w, false
+}
+
+// sameOutput returns true, if a and b are shown on the same screen, so that live printers on a have to be rendered below messages on b.
+// The error output is shown on the same screen as the default output, if both are terminals.
+// The caller has to hold pLock.
+func sameOutput(a, b io.Writer) bool {
+	if a == b {
+		return true
+	}
+	if _, ok := a.(errorOutputWriter); ok {
+		a, b = b, a
+	}
+	if _, ok := b.(errorOutputWriter); !ok || a != nil {
+		return false
+	}
+	return errorOutput == nil || (isTerminalWriter(defaultOutput) && isTerminalWriter(errorOutput))
+}
+
+// isTerminalWriter returns true, if w is a terminal, or if the terminal detection was overridden (see IsTerminal).
+func isTerminalWriter(w io.Writer) bool {
+	if forcedTTY() {
+		return true
+	}
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
 // Sprint formats using the default formats for its operands and returns the resulting string.
 // Spaces are added between operands when neither is a string.
 func Sprint(a ...interface{}) string {
@@ -109,53 +185,75 @@ func PrintOnErrorf(format string, a ...interface{}) {
 
 // Fprint formats using the default formats for its operands and writes to w.
 // Spaces are added between operands when neither is a string.
-// It returns the number of bytes written and any write error encountered.
+// Use FprintE, if you need the number of bytes written and any write error encountered.
 func Fprint(writer io.Writer, a ...interface{}) {
+	_, _ = FprintE(writer, a...)
+}
+
+// FprintE is like Fprint, but returns the number of bytes written and any write error encountered.
+// If a ProgressbarPrinter or SpinnerPrinter is active on the same writer, the message is printed on its own line above it.
+func FprintE(writer io.Writer, a ...interface{}) (int, error) {
+	n, bars, err := fprintAbove(writer, a...)
+	// The progressbars are rendered again below the message.
+	for _, bar := range bars {
+		bar.rerender()
+	}
+	return n, err
+}
+
+// fprintAbove prints the message like FprintE, but returns the active progressbars on the same writer
+// instead of rendering them again. This is used by progressbars, which print above themselves.
+func fprintAbove(writer io.Writer, a ...interface{}) (int, []*ProgressbarPrinter, error) {
 	pLock.Lock()
 	defer pLock.Unlock()
 	if !Output.Load() {
-		return
+		return 0, nil, nil
 	}
 
-	var ret string
-	var printed bool
+	var bars []*ProgressbarPrinter
+	var live bool
 
 	activeProgressBarPrinters.lock.Lock()
 	for _, bar := range activeProgressBarPrinters.printers {
-		if bar.IsActive && bar.Writer == writer {
-			ret += sClearLine()
-			ret += "\r" + color.Sprint(a...)
-			printed = true
+		if bar.IsActive && sameOutput(bar.Writer, writer) && !bar.emitsJSON() {
+			bars = append(bars, bar)
+			live = true
 		}
 	}
 	activeProgressBarPrinters.lock.Unlock()
 
 	activeSpinnerPrinters.lock.Lock()
 	for _, spinner := range activeSpinnerPrinters.printers {
-		if spinner.atomicIsActive.Load() && spinner.Writer == writer {
-			ret += sClearLine()
-			ret += "\r" + color.Sprint(a...)
-			printed = true
+		if spinner.atomicIsActive.Load() && sameOutput(spinner.Writer, writer) {
+			live = true
 		}
 	}
 	activeSpinnerPrinters.lock.Unlock()
 
-	if !printed {
-		ret = color.Sprint(a...)
+	ret, block := printAboveSpinnerBlock(writer, color.Sprint(a...))
+	if live && !block {
+		// The line of the live printer is cleared, and the message gets its own line,
+		// so that the live printer is rendered below it.
+		ret = sClearLine() + "\r" + ret
+		if !strings.HasSuffix(ret, "\n") {
+			ret += "\n"
+		}
 	}
 
-	if writer != nil {
-		color.Fprint(writer, color.Sprint(ret))
-	} else {
-		color.Print(color.Sprint(ret))
-	}
+	n, err := write(writer, color.Sprint(ret))
+	return n, bars, err
 }
 
 // Fprintln formats using the default formats for its operands and writes to w.
 // Spaces are always added between operands and a newline is appended.
-// It returns the number of bytes written and any write error encountered.
+// Use FprintlnE, if you need the number of bytes written and any write error encountered.
 func Fprintln(writer io.Writer, a ...interface{}) {
-	Fprint(writer, Sprint(a...)+"\n")
+	_, _ = FprintlnE(writer, a...)
+}
+
+// FprintlnE is like Fprintln, but returns the number of bytes written and any write error encountered.
+func FprintlnE(writer io.Writer, a ...interface{}) (int, error) {
+	return FprintE(writer, Sprint(a...)+"\n")
 }
 
 // Printo overrides the current line in a terminal.
@@ -176,26 +274,45 @@ func Printo(a ...interface{}) {
 }
 
 // Fprinto prints Printo to a custom writer.
+// Use FprintoE, if you need the number of bytes written and any write error encountered.
 func Fprinto(w io.Writer, a ...interface{}) {
+	_, _ = FprintoE(w, a...)
+}
+
+// FprintoE is like Fprinto, but returns the number of bytes written and any write error encountered.
+func FprintoE(w io.Writer, a ...interface{}) (int, error) {
 	pLock.Lock()
 	defer pLock.Unlock()
 	if !Output.Load() {
-		return
+		return 0, nil
 	}
-	if w != nil {
-		color.Fprint(w, "\r", color.Sprint(a...))
-	} else {
-		color.Print("\r", color.Sprint(a...))
+	return write(w, "\r"+color.Sprint(a...))
+}
+
+// write renders the color tags of s and writes it to w, or to the default output if w is nil.
+// Writes to the default output and the error output are captured, if EnableOutputCapture was called.
+// The caller has to hold pLock.
+func write(w io.Writer, s string) (int, error) {
+	s = color.Render(s)
+	w, captured := resolveWriter(w)
+	if captured && outputCapture.enabled {
+		outputCapture.buffer.WriteString(s)
 	}
+	return io.WriteString(w, s)
 }
 
-// RemoveColorFromString removes color codes from a string.
+// RemoveColorFromString removes color codes and hyperlink escape sequences from a string.
 func RemoveColorFromString(a ...interface{}) string {
-	return color.ClearCode(Sprint(a...))
+	s := color.ClearCode(Sprint(a...))
+	if !strings.Contains(s, "\x1b]8;") {
+		return s
+	}
+	return hyperlinkRegex.ReplaceAllString(s, "")
 }
 
-func fClearLine(writer io.Writer) {
-	Fprinto(writer, strings.Repeat(" ", GetTerminalWidth()))
+func fClearLine(writer io.Writer) error {
+	_, err := FprintoE(writer, strings.Repeat(" ", GetTerminalWidth()))
+	return err
 }
 
 func sClearLine() string {
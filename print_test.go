@@ -1,6 +1,7 @@
 package pterm_test
 
 import (
+	"bytes"
 	"errors"
 	"io"
 	"os"
@@ -284,6 +285,48 @@ func TestFprinto(t *testing.T) {
 	})
 }
 
+func TestFprintE(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := pterm.FprintE(&buf, "Hello, World!")
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 13, n)
+	testza.AssertEqual(t, "Hello, World!", buf.String())
+
+	_, err = pterm.FprintE(failingWriter{}, "Hello, World!")
+	testza.AssertNotNil(t, err)
+}
+
+func TestFprintlnE(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := pterm.FprintlnE(&buf, "Hello, World!")
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 14, n)
+	testza.AssertEqual(t, "Hello, World!\n", buf.String())
+
+	_, err = pterm.FprintlnE(failingWriter{}, "Hello, World!")
+	testza.AssertNotNil(t, err)
+}
+
+func TestFprintoE(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := pterm.FprintoE(&buf, "Hello, World!")
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 14, n)
+	testza.AssertEqual(t, "\rHello, World!", buf.String())
+
+	_, err = pterm.FprintoE(failingWriter{}, "Hello, World!")
+	testza.AssertNotNil(t, err)
+}
+
+func TestFprintE_DisabledOutput(t *testing.T) {
+	pterm.DisableOutput()
+	defer pterm.EnableOutput()
+
+	n, err := pterm.FprintE(failingWriter{}, "Hello, World!")
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 0, n)
+}
+
 func TestSetDefaultOutput(t *testing.T) {
 	pterm.SetDefaultOutput(os.Stdout)
 }
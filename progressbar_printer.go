@@ -2,6 +2,7 @@ package pterm
 
 import (
 	"io"
+	"math/bits"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,6 +28,7 @@ var (
 		LastCharacter:             "█",
 		ElapsedTimeRoundingFactor: time.Second,
 		BarStyle:                  &ThemeDefault.ProgressbarBarStyle,
+		SecondaryBarStyle:         &ThemeDefault.SecondaryStyle,
 		TitleStyle:                &ThemeDefault.ProgressbarTitleStyle,
 		ShowTitle:                 true,
 		ShowCount:                 true,
@@ -36,6 +38,21 @@ var (
 		MaxWidth:                  80,
 	}
 
+	// indeterminateFrameDelay is the time between two frames of an animated ProgressbarPrinter.
+	indeterminateFrameDelay = 100 * time.Millisecond
+
+	// defaultRefreshInterval is the time between two renders of a ProgressbarPrinter, which shows the elapsed time or the rate.
+	defaultRefreshInterval = time.Second
+
+	// rateWindow is the time span, over which the rate of a ProgressbarPrinter is averaged.
+	rateWindow = 5 * time.Second
+
+	// rateMinElapsed is the time, which has to pass, before the rate of a ProgressbarPrinter is shown.
+	rateMinElapsed = time.Second
+
+	// activityIndicatorSequence is the animation of the activity indicator of a ProgressbarPrinter.
+	activityIndicatorSequence = SpinnerSequenceDots
+
 	activeProgressBarPrinters = atomicActiveProgressBarPrinters{
 		printers: []*ProgressbarPrinter{},
 		lock:     &sync.Mutex{},
@@ -51,25 +68,103 @@ type ProgressbarPrinter struct {
 	LastCharacter             string
 	ElapsedTimeRoundingFactor time.Duration
 	BarFiller                 string
-	MaxWidth                  int
+	// BarStart and BarEnd are printed around the bar, like "[" and "]". If they are empty, the bar has no boundaries.
+	BarStart           string
+	BarEnd             string
+	MaxWidth           int
+	PercentageDecimals int
+	// TitleWidth is the fixed display width of the title. Longer titles are truncated, shorter titles are padded.
+	// If TitleWidth is zero, the title is printed as it is.
+	TitleWidth int
 
 	ShowElapsedTime bool
 	ShowCount       bool
 	ShowTitle       bool
 	ShowPercentage  bool
 	RemoveWhenDone  bool
+	// If ShowRate is true, the progress per second is shown after the elapsed time.
+	ShowRate bool
+	// RateUnit is the unit of the rate, like "items". If it is "B", the rate is formatted as bytes, like "42.3 MB/s".
+	RateUnit string
+	// If CompactElapsedTime is true, the elapsed time is shown without zero units, like "1h3s" instead of "1h0m3s".
+	CompactElapsedTime bool
+	// If Indeterminate is true, Total is ignored and a moving segment is shown instead of the progress.
+	Indeterminate bool
+	// If ShowActivityIndicator is true, an animated glyph in front of the title shows that work is going on between increments.
+	ShowActivityIndicator bool
+	// If KeepTitleNewlines is true, all title lines, except the last one, are printed above the bar.
+	// Otherwise, newlines in the title are replaced by spaces.
+	KeepTitleNewlines bool
+	// If JSONOutput is true, a ProgressbarEvent is written as a JSON line per update, instead of the bar.
+	// This is always the case, if the Writer is a JSONProgressWriter.
+	JSONOutput bool
+	// If Reverse is true, the bar is filled from the right to the left.
+	Reverse bool
+	// FixedWidth is the width of the ProgressbarPrinter, regardless of the terminal width. It overrides MaxWidth, if it is greater than zero.
+	FixedWidth int
+	// Clock returns the current time, which is used to calculate the elapsed time. If it is nil, time.Now is used.
+	Clock func() time.Time
+	// RefreshInterval is the time between two renders, while the ProgressbarPrinter shows time-based content,
+	// like the elapsed time, the rate or an animation. If it is zero, animations are rendered every 100ms, and everything else every second.
+	RefreshInterval time.Duration
+	// Logger is called with the progress, whenever the percentage changes, and when the ProgressbarPrinter is stopped.
+	// It is called in addition to rendering the bar.
+	Logger func(current, total int, title string)
 
 	TitleStyle *Style
 	BarStyle   *Style
+	// BarFillerStyle is the style of the unfilled part of the bar. If it is nil, the BarFiller is not styled.
+	BarFillerStyle *Style
+	// LastCharacterStyle is the style of the LastCharacter. If it is nil, the BarStyle is used.
+	LastCharacterStyle *Style
+	// CompletedBarCharacter fills the whole bar, when the progressbar is completed. If it is empty, the bar is rendered as usual.
+	CompletedBarCharacter string
+	// CompletedBarStyle is the style of the bar, when the progressbar is completed. If it is nil, the BarStyle is used.
+	CompletedBarStyle *Style
+	// SecondaryCurrent is a second progress, like verified bytes next to downloaded bytes.
+	// It is drawn over the filled part of the bar, up to SecondaryCurrent, but never beyond Current.
+	SecondaryCurrent int
+	// SecondaryBarStyle is the style of the secondary progress. If it is nil, the BarStyle is used.
+	SecondaryBarStyle *Style
 
 	IsActive bool
 
-	startedAt time.Time
+	startedAt        time.Time
+	paused           bool
+	pausedAt         time.Time
+	pausedDuration   time.Duration
+	lock             *sync.Mutex
+	renderedWidth    int
+	printedTitleHead string
+	frame            int
+	err              error
+	loggedProgress   int
+	rateSamples      []progressbarRateSample
+	tickerDone       chan struct{}
 
 	Writer io.Writer
 }
 
+// progressbarRateSample is the progress of a ProgressbarPrinter at an elapsed time, which is used to calculate the rate.
+type progressbarRateSample struct {
+	elapsed time.Duration
+	current int
+}
+
+// ProgressbarStats is a snapshot of the progress of a ProgressbarPrinter.
+type ProgressbarStats struct {
+	Current int
+	Total   int
+	// Percentage is the completed percentage. It is zero, if Total is zero.
+	Percentage float64
+	// Elapsed is the time since the ProgressbarPrinter was started.
+	Elapsed time.Duration
+	// Rate is the average progress per second since the start.
+	Rate float64
+}
+
 // WithTitle sets the name of the ProgressbarPrinter.
+// Newlines in the title are replaced by spaces, unless KeepTitleNewlines is set.
 func (p ProgressbarPrinter) WithTitle(name string) *ProgressbarPrinter {
 	p.Title = name
 	return &p
@@ -83,6 +178,65 @@ func (p ProgressbarPrinter) WithMaxWidth(maxWidth int) *ProgressbarPrinter {
 	return &p
 }
 
+// WithFixedWidth sets the width of the ProgressbarPrinter, which is used regardless of the terminal width.
+// Together with WithClock, this makes the output deterministic, for example in tests.
+func (p ProgressbarPrinter) WithFixedWidth(width int) *ProgressbarPrinter {
+	p.FixedWidth = width
+	return &p
+}
+
+// WithClock sets the function, which returns the current time for the elapsed time.
+// This can be used to render the elapsed time deterministically in tests.
+//
+// Example:
+//
+//	start := time.Now()
+//	bar, _ := pterm.DefaultProgressbar.WithClock(func() time.Time { return start }).WithFixedWidth(80).WithWriter(&buf).Start()
+func (p ProgressbarPrinter) WithClock(clock func() time.Time) *ProgressbarPrinter {
+	p.Clock = clock
+	return &p
+}
+
+// WithLogger sets a function, which is called with the progress, whenever the percentage changes, and when the ProgressbarPrinter is stopped.
+// This can be used to record the progress in structured logs, where no terminal is available.
+// The logger is called while the ProgressbarPrinter is locked, so it must not call methods of the ProgressbarPrinter.
+//
+// Example:
+//
+//	bar, _ := pterm.DefaultProgressbar.WithLogger(func(current, total int, title string) {
+//		slog.Info("progress", "title", title, "current", current, "total", total)
+//	}).Start()
+func (p ProgressbarPrinter) WithLogger(logger func(current, total int, title string)) *ProgressbarPrinter {
+	p.Logger = logger
+	return &p
+}
+
+// WithRefreshInterval sets the time between two renders, while the ProgressbarPrinter shows time-based content,
+// like the elapsed time, the rate or an animation.
+func (p ProgressbarPrinter) WithRefreshInterval(interval time.Duration) *ProgressbarPrinter {
+	p.RefreshInterval = interval
+	return &p
+}
+
+// WithTitleWidth sets a fixed display width for the title, so that the bars of multiple ProgressbarPrinters start at the same column.
+// Longer titles are truncated with "…", shorter titles are padded with spaces.
+func (p ProgressbarPrinter) WithTitleWidth(width int) *ProgressbarPrinter {
+	if width < 0 {
+		width = 0
+	}
+	p.TitleWidth = width
+	return &p
+}
+
+// WithPercentageDecimals sets the number of decimal places of the shown percentage.
+func (p ProgressbarPrinter) WithPercentageDecimals(n int) *ProgressbarPrinter {
+	if n < 0 {
+		n = 0
+	}
+	p.PercentageDecimals = n
+	return &p
+}
+
 // WithTotal sets the total value of the ProgressbarPrinter.
 func (p ProgressbarPrinter) WithTotal(total int) *ProgressbarPrinter {
 	p.Total = total
@@ -119,6 +273,26 @@ func (p ProgressbarPrinter) WithShowElapsedTime(b ...bool) *ProgressbarPrinter {
 	return &p
 }
 
+// WithShowRate sets if the progress per second should be displayed in the ProgressbarPrinter.
+// The rate is averaged over the last seconds, and "--" is shown until enough time has passed.
+func (p ProgressbarPrinter) WithShowRate(b ...bool) *ProgressbarPrinter {
+	p.ShowRate = internal.WithBoolean(b)
+	return &p
+}
+
+// WithRateUnit sets the unit of the rate, like "items". If the unit is "B", the rate is formatted as bytes, like "42.3 MB/s".
+func (p ProgressbarPrinter) WithRateUnit(unit string) *ProgressbarPrinter {
+	p.RateUnit = unit
+	return &p
+}
+
+// WithCompactElapsedTime sets if the elapsed time should be shown without zero units, like "1h3s" instead of "1h0m3s".
+// This is the same format as putils.FormatDuration.
+func (p ProgressbarPrinter) WithCompactElapsedTime(b ...bool) *ProgressbarPrinter {
+	p.CompactElapsedTime = internal.WithBoolean(b)
+	return &p
+}
+
 // WithShowCount sets if the total and current count should be displayed in the ProgressbarPrinter.
 func (p ProgressbarPrinter) WithShowCount(b ...bool) *ProgressbarPrinter {
 	p.ShowCount = internal.WithBoolean(b)
@@ -149,12 +323,95 @@ func (p ProgressbarPrinter) WithBarStyle(style *Style) *ProgressbarPrinter {
 	return &p
 }
 
+// WithBarFillerStyle sets the style of the unfilled part of the bar.
+func (p ProgressbarPrinter) WithBarFillerStyle(style *Style) *ProgressbarPrinter {
+	p.BarFillerStyle = style
+	return &p
+}
+
+// WithLastCharacterStyle sets the style of the last character of the bar.
+// This can be used to highlight the leading edge of the bar.
+func (p ProgressbarPrinter) WithLastCharacterStyle(style *Style) *ProgressbarPrinter {
+	p.LastCharacterStyle = style
+	return &p
+}
+
+// WithCompletedBarCharacter sets the character, which fills the whole bar when the progressbar is completed.
+func (p ProgressbarPrinter) WithCompletedBarCharacter(char string) *ProgressbarPrinter {
+	p.CompletedBarCharacter = char
+	return &p
+}
+
+// WithCompletedBarStyle sets the style of the bar when the progressbar is completed.
+func (p ProgressbarPrinter) WithCompletedBarStyle(style *Style) *ProgressbarPrinter {
+	p.CompletedBarStyle = style
+	return &p
+}
+
+// WithKeepTitleNewlines sets if newlines in the title are kept.
+// The title lines, except the last one, are then printed above the bar.
+func (p ProgressbarPrinter) WithKeepTitleNewlines(b ...bool) *ProgressbarPrinter {
+	p.KeepTitleNewlines = internal.WithBoolean(b)
+	return &p
+}
+
+// WithIndeterminate sets if the ProgressbarPrinter should show an animated segment instead of the progress.
+// This is useful for operations of unknown length. Total, the count and the percentage are ignored.
+func (p ProgressbarPrinter) WithIndeterminate(b ...bool) *ProgressbarPrinter {
+	p.Indeterminate = internal.WithBoolean(b)
+	return &p
+}
+
+// WithActivityIndicator sets if an animated glyph should be shown in front of the title, while the ProgressbarPrinter is running.
+// The glyph is removed, when the ProgressbarPrinter is stopped.
+func (p ProgressbarPrinter) WithActivityIndicator(b ...bool) *ProgressbarPrinter {
+	p.ShowActivityIndicator = internal.WithBoolean(b)
+	return &p
+}
+
+// WithJSONOutput sets if the ProgressbarPrinter should write a ProgressbarEvent as a JSON line per update, instead of the bar.
+// This is useful, if the output is read by another program.
+func (p ProgressbarPrinter) WithJSONOutput(b ...bool) *ProgressbarPrinter {
+	p.JSONOutput = internal.WithBoolean(b)
+	return &p
+}
+
+// WithReverse sets if the bar should be filled from the right to the left.
+func (p ProgressbarPrinter) WithReverse(b ...bool) *ProgressbarPrinter {
+	p.Reverse = internal.WithBoolean(b)
+	return &p
+}
+
 // WithRemoveWhenDone sets if the ProgressbarPrinter should be removed when it is done.
 func (p ProgressbarPrinter) WithRemoveWhenDone(b ...bool) *ProgressbarPrinter {
 	p.RemoveWhenDone = internal.WithBoolean(b)
 	return &p
 }
 
+// WithBarStart sets the boundary character, which is printed in front of the bar, like "[".
+func (p ProgressbarPrinter) WithBarStart(start string) *ProgressbarPrinter {
+	p.BarStart = start
+	return &p
+}
+
+// WithBarEnd sets the boundary character, which is printed after the bar, like "]".
+func (p ProgressbarPrinter) WithBarEnd(end string) *ProgressbarPrinter {
+	p.BarEnd = end
+	return &p
+}
+
+// WithSecondaryCurrent sets a second progress, which is drawn over the filled part of the bar.
+func (p ProgressbarPrinter) WithSecondaryCurrent(current int) *ProgressbarPrinter {
+	p.SecondaryCurrent = current
+	return &p
+}
+
+// WithSecondaryBarStyle sets the style of the secondary progress.
+func (p ProgressbarPrinter) WithSecondaryBarStyle(style *Style) *ProgressbarPrinter {
+	p.SecondaryBarStyle = style
+	return &p
+}
+
 // WithBarFiller sets the filler character for the ProgressbarPrinter.
 func (p ProgressbarPrinter) WithBarFiller(char string) *ProgressbarPrinter {
 	p.BarFiller = char
@@ -175,28 +432,108 @@ func (p *ProgressbarPrinter) Increment() *ProgressbarPrinter {
 
 // UpdateTitle updates the title and re-renders the progressbar
 func (p *ProgressbarPrinter) UpdateTitle(title string) *ProgressbarPrinter {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	p.Title = title
 	p.updateProgress()
 	return p
 }
 
-// This is the update logic, renders the progressbar
-func (p *ProgressbarPrinter) updateProgress() *ProgressbarPrinter {
-	if p.TitleStyle == nil {
-		p.TitleStyle = NewStyle()
+// UpdateSecondaryCurrent updates the secondary progress and re-renders the progressbar.
+func (p *ProgressbarPrinter) UpdateSecondaryCurrent(current int) *ProgressbarPrinter {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.SecondaryCurrent = current
+	p.updateProgress()
+	return p
+}
+
+// SetTotal updates the total and re-renders the progressbar.
+// It can be used, while the progressbar is running, for example when more work is discovered.
+// If the total is not above Current, Current is reduced to the total and the progressbar is finished.
+func (p *ProgressbarPrinter) SetTotal(total int) *ProgressbarPrinter {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if total < 0 {
+		total = 0
 	}
-	if p.BarStyle == nil {
-		p.BarStyle = NewStyle()
+	p.Total = total
+	if total > 0 && p.Current > total {
+		p.Current = total
 	}
-	if p.Total == 0 {
+	p.updateProgress()
+
+	if total > 0 && p.Current >= total && !p.Indeterminate {
+		p.stop()
+	}
+	return p
+}
+
+// lazyInit initializes the lock, which serializes updates of the ProgressbarPrinter.
+// The lock is created in Start, so a started ProgressbarPrinter can be used from multiple goroutines.
+func (p *ProgressbarPrinter) lazyInit() {
+	if p.lock == nil {
+		p.lock = &sync.Mutex{}
+	}
+}
+
+// Srender returns the progressbar with its current values as a string, without printing it.
+// The ProgressbarPrinter does not have to be started.
+// If the Total is zero and the progressbar is not indeterminate, an empty string is returned.
+func (p *ProgressbarPrinter) Srender() string {
+	if p.lock != nil {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+	}
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+	_, line := p.getString()
+	return line
+}
+
+// This is the update logic, renders the progressbar.
+// The caller has to hold the lock.
+func (p *ProgressbarPrinter) updateProgress() *ProgressbarPrinter {
+	if p.Total == 0 && !p.Indeterminate {
 		return nil
 	}
+	p.logProgress(false)
+	if p.emitsJSON() {
+		p.writeEvent("progress")
+		return p
+	}
+
+	p.printTitleHead()
+	if p.ShowRate {
+		p.sampleRate()
+	}
+	themeLock.RLock()
+	width, line := p.getString()
+	themeLock.RUnlock()
+	p.render(width, line)
+	return p
+}
+
+// getString returns the line of the progressbar and the width it was rendered for.
+// It neither prints anything nor changes the ProgressbarPrinter, so it can be used by Srender.
+// The caller has to hold the lock, if the ProgressbarPrinter was started.
+func (p *ProgressbarPrinter) getString() (width int, line string) {
+	if p.Total == 0 && !p.Indeterminate {
+		return 0, ""
+	}
 
 	var before string
 	var after string
-	var width int
 
-	if p.MaxWidth <= 0 {
+	if p.FixedWidth > 0 {
+		width = p.FixedWidth
+	} else if p.MaxWidth <= 0 {
 		width = GetTerminalWidth()
 	} else if GetTerminalWidth() < p.MaxWidth {
 		width = GetTerminalWidth()
@@ -204,101 +541,489 @@ func (p *ProgressbarPrinter) updateProgress() *ProgressbarPrinter {
 		width = p.MaxWidth
 	}
 
-	currentPercentage := int(internal.PercentageRound(float64(int64(p.Total)), float64(int64(p.Current))))
+	currentPercentage := strconv.Itoa(int(internal.PercentageRound(float64(int64(p.Total)), float64(int64(p.Current)))))
+	if p.PercentageDecimals > 0 {
+		currentPercentage = strconv.FormatFloat(internal.Percentage(float64(p.Total), float64(p.Current)), 'f', p.PercentageDecimals, 64)
+	}
 
 	decoratorCount := Gray("[") + LightWhite(p.Current) + Gray("/") + LightWhite(p.Total) + Gray("]")
 
 	decoratorCurrentPercentage := color.RGB(NewRGB(255, 0, 0).Fade(0, float32(p.Total), float32(p.Current), NewRGB(0, 255, 0)).GetValues()).
-		Sprint(strconv.Itoa(currentPercentage) + "%")
+		Sprint(currentPercentage + "%")
 
-	decoratorTitle := p.TitleStyle.Sprint(p.Title)
+	title := p.barTitle()
+	if p.paused {
+		title += " (paused)"
+	}
+	if p.TitleWidth > 0 {
+		title = internal.TruncateString(title, p.TitleWidth, "…")
+		title += strings.Repeat(" ", p.TitleWidth-internal.DisplayWidth(title))
+	}
+	decoratorTitle := p.titleStyle().Sprint(title)
 
+	if p.ShowActivityIndicator && p.IsActive {
+		before += p.barStyle().Sprint(activityIndicatorSequence[p.frame%len(activityIndicatorSequence)]) + " "
+	}
 	if p.ShowTitle {
 		before += decoratorTitle + " "
 	}
-	if p.ShowCount {
+	if p.ShowCount && !p.Indeterminate {
 		before += decoratorCount + " "
 	}
 
 	after += " "
 
-	if p.ShowPercentage {
+	if p.ShowPercentage && !p.Indeterminate {
 		after += decoratorCurrentPercentage + " "
 	}
 	if p.ShowElapsedTime {
 		after += "| " + p.parseElapsedTime()
 	}
+	if p.ShowRate {
+		if p.ShowElapsedTime {
+			after += " "
+		}
+		after += "| " + p.rate()
+	}
+
+	barMaxLength := width - internal.DisplayWidth(before) - internal.DisplayWidth(after) - 1 -
+		internal.DisplayWidth(p.BarStart) - internal.DisplayWidth(p.BarEnd)
 
-	barMaxLength := width - len(RemoveColorFromString(before)) - len(RemoveColorFromString(after)) - 1
+	if p.Indeterminate {
+		return width, before + p.BarStart + p.indeterminateBar(barMaxLength) + p.BarEnd + after
+	}
 
-	barCurrentLength := (p.Current * barMaxLength) / p.Total
+	barCurrentLength := p.filledLength(p.Current, barMaxLength)
 	var barFiller string
 	if barMaxLength-barCurrentLength > 0 {
-		barFiller = strings.Repeat(p.BarFiller, barMaxLength-barCurrentLength)
+		barFiller = p.styleBarFiller(strings.Repeat(p.BarFiller, barMaxLength-barCurrentLength))
 	}
 
 	var bar string
-	if barCurrentLength > 0 {
-		bar = p.BarStyle.Sprint(strings.Repeat(p.BarCharacter, barCurrentLength)+p.LastCharacter) + barFiller
+	if p.Current >= p.Total && (p.CompletedBarCharacter != "" || p.CompletedBarStyle != nil) {
+		bar = p.completedBar(barCurrentLength)
+	} else if barCurrentLength > 0 {
+		secondaryCurrent := p.SecondaryCurrent
+		if secondaryCurrent > p.Current {
+			secondaryCurrent = p.Current
+		}
+		bar = p.filledBar(barCurrentLength, p.filledLength(secondaryCurrent, barMaxLength))
+		if p.Reverse {
+			bar = barFiller + bar
+		} else {
+			bar += barFiller
+		}
+	} else if p.BarStart != "" || p.BarEnd != "" {
+		// the boundaries stay in place, while nothing is filled
+		bar = p.styleBarFiller(strings.Repeat(p.BarFiller, barMaxLength+1))
 	} else {
 		bar = ""
 	}
 
-	if !RawOutput.Load() {
-		Fprinto(p.Writer, before+bar+after)
+	if p.BarStart != "" || p.BarEnd != "" {
+		bar = p.BarStart + bar + p.BarEnd
+	}
+	return width, before + bar + after
+}
+
+// filledLength returns the length of the part of a bar with the given length, which is filled up to current, clamped to [0, length].
+// The product of current and length is calculated with 128 bits, so that huge totals, like byte counts, don't overflow.
+func (p *ProgressbarPrinter) filledLength(current, length int) int {
+	if length <= 0 || p.Total <= 0 || current <= 0 {
+		return 0
+	}
+	if current >= p.Total {
+		return length
+	}
+	hi, lo := bits.Mul64(uint64(current), uint64(length))
+	filled, _ := bits.Div64(hi, lo, uint64(p.Total))
+	return int(filled)
+}
+
+// render prints the line of the progressbar.
+// Nothing is printed anymore, after writing to the Writer failed.
+func (p *ProgressbarPrinter) render(width int, line string) {
+	if RawOutput.Load() || p.err != nil {
+		return
+	}
+	// The terminal was resized since the last render, so the old line has to be cleared.
+	if p.renderedWidth != 0 && p.renderedWidth != width {
+		p.setErr(fClearLine(p.Writer))
+	}
+	p.renderedWidth = width
+	_, err := FprintoE(p.Writer, line)
+	p.setErr(err)
+}
+
+// logProgress calls the Logger, if the percentage changed since the last call, or if force is true.
+// The progress of an indeterminate ProgressbarPrinter is logged, whenever Current changes.
+func (p *ProgressbarPrinter) logProgress(force bool) {
+	if p.Logger == nil {
+		return
+	}
+	progress := p.Current
+	if !p.Indeterminate && p.Total != 0 {
+		progress = int(internal.PercentageRound(float64(p.Total), float64(p.Current)))
+	}
+	if !force && progress == p.loggedProgress {
+		return
+	}
+	p.loggedProgress = progress
+	p.Logger(p.Current, p.Total, p.Title)
+}
+
+// emitsJSON returns true, if the ProgressbarPrinter writes JSON events instead of the bar.
+func (p *ProgressbarPrinter) emitsJSON() bool {
+	_, ok := p.Writer.(*JSONProgressWriter)
+	return ok || p.JSONOutput
+}
+
+// writeEvent writes a ProgressbarEvent of the given type.
+// Nothing is written anymore, after writing to the Writer failed.
+func (p *ProgressbarPrinter) writeEvent(eventType string) {
+	if p.err != nil || !Output.Load() {
+		return
+	}
+	event := ProgressbarEvent{
+		Type:    eventType,
+		Title:   p.Title,
+		Current: p.Current,
+		Total:   p.Total,
+		Elapsed: p.GetElapsedTime().Seconds(),
+	}
+	if p.Total != 0 && !p.Indeterminate {
+		event.Percentage = internal.Percentage(float64(p.Total), float64(p.Current))
+	}
+
+	if w, ok := p.Writer.(*JSONProgressWriter); ok {
+		p.setErr(w.WriteEvent(event))
+		return
+	}
+	pLock.Lock()
+	defer pLock.Unlock()
+	w := p.Writer
+	if w == nil {
+		w = defaultOutput
+	}
+	p.setErr(writeJSONLine(w, event))
+}
+
+// setErr stores the first error, which occurred while writing to the Writer.
+func (p *ProgressbarPrinter) setErr(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+// Err returns the first error, which occurred while writing to the Writer.
+// After an error occurred, the ProgressbarPrinter doesn't print anymore, and Stop returns the error.
+func (p *ProgressbarPrinter) Err() error {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.err
+}
+
+// indeterminateBar returns a segment, which bounces between both ends of the bar with every frame.
+// A stopped indeterminate progressbar is shown completely filled.
+func (p *ProgressbarPrinter) indeterminateBar(length int) string {
+	if length <= 0 {
+		return ""
+	}
+	if !p.IsActive {
+		return p.barStyle().Sprint(strings.Repeat(p.BarCharacter, length))
+	}
+
+	segment := length / 5
+	if segment < 1 {
+		segment = 1
+	}
+	pos := 0
+	if maxPos := length - segment; maxPos > 0 {
+		pos = p.frame % (2 * maxPos)
+		if pos > maxPos {
+			pos = 2*maxPos - pos
+		}
+	}
+
+	return p.styleBarFiller(strings.Repeat(p.BarFiller, pos)) +
+		p.barStyle().Sprint(strings.Repeat(p.BarCharacter, segment)) +
+		p.styleBarFiller(strings.Repeat(p.BarFiller, length-pos-segment))
+}
+
+// filledBar returns the filled part of the bar, which ends with the LastCharacter.
+// The first secondaryLength characters show the secondary progress.
+// If Reverse is true, the LastCharacter is at the start of the filled part.
+func (p *ProgressbarPrinter) filledBar(length, secondaryLength int) string {
+	var secondary string
+	if secondaryLength > 0 {
+		style := p.SecondaryBarStyle
+		if style == nil {
+			style = p.barStyle()
+		}
+		secondary = style.Sprint(strings.Repeat(p.BarCharacter, secondaryLength))
+	}
+
+	filled := strings.Repeat(p.BarCharacter, length-secondaryLength)
+	if p.LastCharacterStyle == nil {
+		if p.Reverse {
+			return p.barStyle().Sprint(p.LastCharacter+filled) + secondary
+		}
+		return secondary + p.barStyle().Sprint(filled+p.LastCharacter)
+	}
+	if p.Reverse {
+		return p.LastCharacterStyle.Sprint(p.LastCharacter) + p.barStyle().Sprint(filled) + secondary
+	}
+	return secondary + p.barStyle().Sprint(filled) + p.LastCharacterStyle.Sprint(p.LastCharacter)
+}
+
+// completedBar returns the bar of a completed progressbar, using the CompletedBarCharacter and CompletedBarStyle.
+func (p *ProgressbarPrinter) completedBar(length int) string {
+	style := p.CompletedBarStyle
+	if style == nil {
+		style = p.barStyle()
+	}
+	if p.CompletedBarCharacter == "" {
+		return style.Sprint(strings.Repeat(p.BarCharacter, length) + p.LastCharacter)
+	}
+	return style.Sprint(strings.Repeat(p.CompletedBarCharacter, length+1))
+}
+
+// styleBarFiller applies the BarFillerStyle to the unfilled part of the bar.
+func (p *ProgressbarPrinter) styleBarFiller(filler string) string {
+	if p.BarFillerStyle == nil || filler == "" {
+		return filler
+	}
+	return p.BarFillerStyle.Sprint(filler)
+}
+
+// rerender renders an active ProgressbarPrinter again.
+// This is used after a message was printed above the progressbar.
+func (p *ProgressbarPrinter) rerender() {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.IsActive {
+		p.updateProgress()
+	}
+}
+
+// startTicker starts re-rendering the ProgressbarPrinter in the refresh interval, if it shows time-based content.
+// The caller has to hold the lock.
+func (p *ProgressbarPrinter) startTicker() {
+	if !(p.Indeterminate || p.ShowActivityIndicator || p.ShowElapsedTime || p.ShowRate) || RawOutput.Load() || p.emitsJSON() {
+		return
+	}
+	interval := p.RefreshInterval
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+		if p.Indeterminate || p.ShowActivityIndicator {
+			interval = indeterminateFrameDelay
+		}
+	}
+	done := make(chan struct{})
+	p.tickerDone = done
+	go p.tick(done, interval)
+}
+
+// stopTicker stops re-rendering the ProgressbarPrinter. The caller has to hold the lock.
+func (p *ProgressbarPrinter) stopTicker() {
+	if p.tickerDone != nil {
+		close(p.tickerDone)
+		p.tickerDone = nil
+	}
+}
+
+// tick re-renders the ProgressbarPrinter in the interval, until done is closed.
+func (p *ProgressbarPrinter) tick(done chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		p.lock.Lock()
+		// the ticker might have been stopped, while waiting for the lock
+		select {
+		case <-done:
+			p.lock.Unlock()
+			return
+		default:
+		}
+		p.frame++
+		p.updateProgress()
+		p.lock.Unlock()
 	}
-	return p
 }
 
 // Add to current value.
+// Add can be called from multiple goroutines, after the ProgressbarPrinter is started.
 func (p *ProgressbarPrinter) Add(count int) *ProgressbarPrinter {
-	if p.Total == 0 {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.Total == 0 && !p.Indeterminate {
 		return nil
 	}
 
 	p.Current += count
 	p.updateProgress()
 
-	if p.Current >= p.Total {
-		p.Stop()
+	if p.Current >= p.Total && !p.Indeterminate {
+		p.stop()
 	}
 	return p
 }
 
 // Start the ProgressbarPrinter.
 func (p ProgressbarPrinter) Start(title ...interface{}) (*ProgressbarPrinter, error) {
-	if RawOutput.Load() && p.ShowTitle {
-		Fprintln(p.Writer, p.Title)
+	if RawOutput.Load() && p.ShowTitle && !p.emitsJSON() {
+		_, _, p.err = fprintAbove(p.Writer, p.Title+"\n")
 	}
 	p.IsActive = true
+	p.paused = false
+	p.pausedDuration = 0
+	p.loggedProgress = -1
+	p.lock = &sync.Mutex{}
 	if len(title) != 0 {
 		p.Title = Sprint(title...)
 	}
 
+	// The bar stays locked until its first frame is rendered, because printing above
+	// it re-renders every registered bar.
+	p.lock.Lock()
 	activeProgressBarPrinters.lock.Lock()
 	activeProgressBarPrinters.printers = append(activeProgressBarPrinters.printers, &p)
 	activeProgressBarPrinters.lock.Unlock()
 
-	p.startedAt = time.Now()
+	p.startedAt = p.now()
+	p.rateSamples = []progressbarRateSample{{current: p.Current}}
 
 	p.updateProgress()
+	err := p.err
+	p.startTicker()
+	p.lock.Unlock()
 
-	return &p, nil
+	return &p, err
+}
+
+// Pause pauses a running ProgressbarPrinter.
+// The elapsed time doesn't count while the ProgressbarPrinter is paused, and the title shows a "(paused)" suffix.
+// Progress can still be added while the ProgressbarPrinter is paused.
+func (p *ProgressbarPrinter) Pause() *ProgressbarPrinter {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.IsActive || p.paused {
+		return p
+	}
+	p.paused = true
+	p.pausedAt = p.now()
+	p.stopTicker()
+	p.updateProgress()
+	return p
+}
+
+// Resume resumes a paused ProgressbarPrinter.
+func (p *ProgressbarPrinter) Resume() *ProgressbarPrinter {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.paused {
+		return p
+	}
+	p.resume()
+	if p.IsActive {
+		p.startTicker()
+		p.updateProgress()
+	}
+	return p
+}
+
+// resume adds the paused interval to the paused duration.
+// The caller has to hold the lock.
+func (p *ProgressbarPrinter) resume() {
+	p.pausedDuration += p.now().Sub(p.pausedAt)
+	p.paused = false
+}
+
+// IsPaused returns true, if the ProgressbarPrinter is paused.
+func (p *ProgressbarPrinter) IsPaused() bool {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.paused
 }
 
 // Stop the ProgressbarPrinter.
+// It returns the first error, which occurred while writing to the Writer.
 func (p *ProgressbarPrinter) Stop() (*ProgressbarPrinter, error) {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.stop()
+	return p, p.err
+}
+
+// stop stops the ProgressbarPrinter, if it is active.
+// The caller has to hold the lock.
+func (p *ProgressbarPrinter) stop() {
 	if !p.IsActive {
-		return p, nil
+		return
 	}
+	// IsActive is read by Fprint while holding the lock of the active printers.
+	// Copies of the ProgressbarPrinter share the lock, so they are stopped as well.
+	activeProgressBarPrinters.lock.Lock()
 	p.IsActive = false
+	active := activeProgressBarPrinters.printers[:0]
+	for _, bar := range activeProgressBarPrinters.printers {
+		if bar.lock == p.lock {
+			bar.IsActive = false
+			continue
+		}
+		active = append(active, bar)
+	}
+	activeProgressBarPrinters.printers = active
+	activeProgressBarPrinters.lock.Unlock()
+	p.stopTicker()
+	wasPaused := p.paused
+	if wasPaused {
+		p.resume()
+	}
+	p.logProgress(true)
+	if p.emitsJSON() {
+		if !p.Indeterminate && p.Current >= p.Total {
+			p.writeEvent("done")
+		} else {
+			p.writeEvent("stopped")
+		}
+		return
+	}
+	if p.err != nil {
+		return
+	}
 	if p.RemoveWhenDone {
-		fClearLine(p.Writer)
-		Fprinto(p.Writer)
+		p.setErr(fClearLine(p.Writer))
+		_, err := FprintoE(p.Writer)
+		p.setErr(err)
 	} else {
-		Fprintln(p.Writer)
+		if p.Indeterminate || p.ShowActivityIndicator || wasPaused {
+			// show the indeterminate progressbar as done and remove the activity indicator and the paused suffix
+			p.updateProgress()
+		}
+		_, _, err := fprintAbove(p.Writer, "\n")
+		p.setErr(err)
 	}
-	return p, nil
 }
 
 // GenericStart runs Start, but returns a LivePrinter.
@@ -319,12 +1044,154 @@ func (p ProgressbarPrinter) GenericStop() (*LivePrinter, error) {
 	return &lp, nil
 }
 
+// barTitle returns the part of the title, which is printed in the same line as the bar.
+// If KeepTitleNewlines is set, the other title lines are printed above the bar by printTitleHead.
+func (p *ProgressbarPrinter) barTitle() string {
+	_, tail := p.splitTitle()
+	return tail
+}
+
+// splitTitle splits the title into the lines, which are printed above the bar, and the line, which is printed in the same line as the bar.
+// The head is only set, if KeepTitleNewlines is set and the title contains newlines.
+func (p *ProgressbarPrinter) splitTitle() (head, tail string) {
+	title := strings.ReplaceAll(strings.ReplaceAll(p.Title, "\r\n", "\n"), "\r", "\n")
+	if !p.KeepTitleNewlines {
+		return "", strings.ReplaceAll(title, "\n", " ")
+	}
+
+	i := strings.LastIndex(title, "\n")
+	if i == -1 {
+		return "", title
+	}
+	return title[:i], title[i+1:]
+}
+
+// printTitleHead prints the lines of the title above the bar, which are not printed in the same line as the bar.
+// They are only printed again, if they changed. The caller has to hold the lock.
+func (p *ProgressbarPrinter) printTitleHead() {
+	head, _ := p.splitTitle()
+	if head == "" || head == p.printedTitleHead || !p.ShowTitle || RawOutput.Load() || p.err != nil {
+		return
+	}
+	themeLock.RLock()
+	text := p.titleStyle().Sprint(head)
+	themeLock.RUnlock()
+	// The bar is rendered by the caller, so it must not be rendered again by FprintE.
+	_, _, err := fprintAbove(p.Writer, text+"\n")
+	p.setErr(err)
+	p.printedTitleHead = head
+}
+
+// titleStyle returns the TitleStyle, or an empty style, if it is nil.
+func (p *ProgressbarPrinter) titleStyle() *Style {
+	if p.TitleStyle == nil {
+		return NewStyle()
+	}
+	return p.TitleStyle
+}
+
+// barStyle returns the BarStyle, or an empty style, if it is nil.
+func (p *ProgressbarPrinter) barStyle() *Style {
+	if p.BarStyle == nil {
+		return NewStyle()
+	}
+	return p.BarStyle
+}
+
+// Stats returns a consistent snapshot of the progress.
+// It can be called from multiple goroutines, while the ProgressbarPrinter is running.
+func (p *ProgressbarPrinter) Stats() ProgressbarStats {
+	p.lazyInit()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	stats := ProgressbarStats{
+		Current: p.Current,
+		Total:   p.Total,
+	}
+	if p.Total != 0 {
+		stats.Percentage = internal.Percentage(float64(p.Total), float64(p.Current))
+	}
+	stats.Elapsed = p.elapsed()
+	if seconds := stats.Elapsed.Seconds(); seconds > 0 {
+		stats.Rate = float64(p.Current) / seconds
+	}
+
+	return stats
+}
+
 // GetElapsedTime returns the elapsed time, since the ProgressbarPrinter was started.
+// The time, in which the ProgressbarPrinter was paused, is not included.
 func (p *ProgressbarPrinter) GetElapsedTime() time.Duration {
-	return time.Since(p.startedAt)
+	end := p.now()
+	if p.paused {
+		end = p.pausedAt
+	}
+	return end.Sub(p.startedAt) - p.pausedDuration
+}
+
+// elapsed is like GetElapsedTime, but returns zero, if the ProgressbarPrinter was never started.
+func (p *ProgressbarPrinter) elapsed() time.Duration {
+	if p.startedAt.IsZero() {
+		return 0
+	}
+	return p.GetElapsedTime()
+}
+
+// now returns the current time of the Clock.
+func (p *ProgressbarPrinter) now() time.Time {
+	if p.Clock != nil {
+		return p.Clock()
+	}
+	return time.Now()
+}
+
+// sampleRate records the current progress for the rate and drops the samples, which are outside of the rateWindow.
+// The caller has to hold the lock.
+func (p *ProgressbarPrinter) sampleRate() {
+	elapsed := p.elapsed()
+	if len(p.rateSamples) == 0 || p.rateSamples[len(p.rateSamples)-1].current != p.Current {
+		p.rateSamples = append(p.rateSamples, progressbarRateSample{elapsed: elapsed, current: p.Current})
+	}
+	// the newest sample, which is older than the window, is kept as the start of the window
+	for len(p.rateSamples) > 1 && elapsed-p.rateSamples[1].elapsed >= rateWindow {
+		p.rateSamples = p.rateSamples[1:]
+	}
+}
+
+// rate returns the formatted progress per second, averaged over the rateWindow.
+// "--" is returned, until rateMinElapsed has passed.
+func (p *ProgressbarPrinter) rate() string {
+	elapsed := p.elapsed()
+	if len(p.rateSamples) == 0 {
+		return "--"
+	}
+	// the newest sample, which is older than the window, is the start of the window
+	start := 0
+	for start < len(p.rateSamples)-1 && elapsed-p.rateSamples[start+1].elapsed >= rateWindow {
+		start++
+	}
+
+	first := p.rateSamples[start]
+	if elapsed < rateMinElapsed || elapsed <= first.elapsed {
+		return "--"
+	}
+	rate := float64(p.Current-first.current) / (elapsed - first.elapsed).Seconds()
+
+	switch p.RateUnit {
+	case "B":
+		return internal.FormatBytes(int64(rate), true) + "/s"
+	case "":
+		return strconv.FormatFloat(rate, 'f', 1, 64) + "/s"
+	default:
+		return strconv.FormatFloat(rate, 'f', 1, 64) + " " + p.RateUnit + "/s"
+	}
 }
 
 func (p *ProgressbarPrinter) parseElapsedTime() string {
-	s := p.GetElapsedTime().Round(p.ElapsedTimeRoundingFactor).String()
-	return s
+	elapsed := p.elapsed().Round(p.ElapsedTimeRoundingFactor)
+	if p.CompactElapsedTime {
+		return internal.FormatDuration(elapsed)
+	}
+	return elapsed.String()
 }
@@ -1,13 +1,18 @@
 package pterm_test
 
 import (
+	"bytes"
 	"io"
+	"math"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"atomicgo.dev/cursor"
 	"github.com/MarvinJWendt/testza"
+	"github.com/mattn/go-runewidth"
 	"github.com/pterm/pterm"
 )
 
@@ -274,3 +279,853 @@ func TestProgressbarPrinter_InstallingPseudoList(t *testing.T) {
 	testza.AssertContains(t, out, "3s")
 	testza.AssertContains(t, out, "Could not install pseudo-minecraft, The company policy forbids games.")
 }
+
+func TestProgressbarPrinter_ClearsLineAfterResize(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithMaxWidth(0).WithWriter(&buf).Start()
+	pterm.SetForcedTerminalSize(40, terminalHeight)
+	defer pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+	buf.Reset()
+	p.Increment()
+	testza.AssertContains(t, buf.String(), "\r"+strings.Repeat(" ", 40))
+	buf.Reset()
+	p.Increment()
+	testza.AssertNotContains(t, buf.String(), strings.Repeat(" ", 40))
+	p.Stop()
+}
+
+func TestProgressbarPrinter_WithPercentageDecimals(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithPercentageDecimals(2)
+
+	testza.AssertEqual(t, 2, p2.PercentageDecimals)
+	testza.AssertZero(t, p.PercentageDecimals)
+}
+
+func TestProgressbarPrinter_PercentageDecimals(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(1000).WithPercentageDecimals(1).WithWriter(&buf).Start()
+	p.Add(5)
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), " 0.5% ")
+	p.Stop()
+}
+
+func TestProgressbarPrinter_PercentageWithoutDecimals(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(1000).WithWriter(&buf).Start()
+	p.Add(5)
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), " 1% ")
+	p.Stop()
+}
+
+func TestProgressbarPrinter_WithKeepTitleNewlines(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithKeepTitleNewlines()
+
+	testza.AssertTrue(t, p2.KeepTitleNewlines)
+	testza.AssertFalse(t, p.KeepTitleNewlines)
+}
+
+func TestProgressbarPrinter_TitleNewlinesAreReplaced(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithTitle("first\nsecond").WithWriter(&buf).Start()
+	out := pterm.RemoveColorFromString(buf.String())
+	testza.AssertContains(t, out, "first second")
+	testza.AssertNotContains(t, out, "\n")
+	p.Stop()
+}
+
+func TestProgressbarPrinter_TitleNewlinesAreKept(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithTitle("first\nsecond").WithKeepTitleNewlines().WithWriter(&buf).Start()
+	p.Increment()
+	out := pterm.RemoveColorFromString(buf.String())
+	testza.AssertEqual(t, 1, strings.Count(out, "first\n"))
+	testza.AssertNotContains(t, out, "first second")
+	testza.AssertContains(t, out, "\rsecond ")
+	p.Stop()
+}
+
+func TestProgressbarPrinter_ConcurrentAdd(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(1000).WithWriter(&buf).Start()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				p.Increment()
+			}
+		}()
+	}
+	wg.Wait()
+
+	testza.AssertEqual(t, 1000, p.Current)
+	testza.AssertFalse(t, p.IsActive)
+	// The final newline of Stop is printed exactly once.
+	testza.AssertEqual(t, 1, strings.Count(buf.String(), "\n"))
+}
+
+func TestProgressbarPrinter_WithIndeterminate(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithIndeterminate()
+
+	testza.AssertTrue(t, p2.Indeterminate)
+	testza.AssertFalse(t, p.Indeterminate)
+}
+
+func TestProgressbarPrinter_Indeterminate(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(0).WithIndeterminate().WithTitle("Working").WithWriter(&buf).Start()
+	p.Add(1000)
+	time.Sleep(250 * time.Millisecond)
+	testza.AssertTrue(t, p.IsActive)
+	p.Stop()
+
+	out := pterm.RemoveColorFromString(buf.String())
+	testza.AssertContains(t, out, "Working")
+	testza.AssertNotContains(t, out, "%")
+	testza.AssertNotContains(t, out, "[1000/")
+	testza.AssertFalse(t, p.IsActive)
+
+	// the last rendered line shows a completely filled bar
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\r")
+	testza.AssertContains(t, lines[len(lines)-1], strings.Repeat("█", 20))
+}
+
+func TestProgressbarPrinter_WithActivityIndicator(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithActivityIndicator()
+
+	testza.AssertTrue(t, p2.ShowActivityIndicator)
+	testza.AssertFalse(t, p.ShowActivityIndicator)
+}
+
+func TestProgressbarPrinter_ActivityIndicator(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithActivityIndicator().WithShowElapsedTime(false).WithTitle("Working").WithWriter(&buf).Start()
+	time.Sleep(250 * time.Millisecond)
+	p.Stop()
+
+	out := pterm.RemoveColorFromString(buf.String())
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\r")
+
+	// the indicator is animated without any progress
+	testza.AssertContains(t, out, pterm.SpinnerSequenceDots[0]+" Working")
+	testza.AssertContains(t, out, pterm.SpinnerSequenceDots[1]+" Working")
+	// the indicator is removed on stop
+	testza.AssertTrue(t, strings.HasPrefix(lines[len(lines)-1], "Working"))
+}
+
+func TestProgressbarPrinter_WithRefreshInterval(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithRefreshInterval(time.Millisecond * 20)
+
+	testza.AssertEqual(t, time.Millisecond*20, p2.RefreshInterval)
+	testza.AssertZero(t, p.RefreshInterval)
+}
+
+func TestProgressbarPrinter_RefreshesElapsedTimeWithoutProgress(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithRefreshInterval(10 * time.Millisecond).WithWriter(&buf).Start()
+	time.Sleep(100 * time.Millisecond)
+	p.Pause()
+	renders := strings.Count(buf.String(), "\r")
+	testza.AssertGreater(t, renders, 3)
+
+	// no renders while paused
+	time.Sleep(50 * time.Millisecond)
+	testza.AssertEqual(t, renders, strings.Count(buf.String(), "\r"))
+	p.Stop()
+}
+
+func TestProgressbarPrinter_NoRefreshWithoutTimeBasedContent(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithShowElapsedTime(false).WithRefreshInterval(10 * time.Millisecond).WithWriter(&buf).Start()
+	renders := strings.Count(buf.String(), "\r")
+	time.Sleep(50 * time.Millisecond)
+	testza.AssertEqual(t, renders, strings.Count(buf.String(), "\r"))
+	p.Stop()
+}
+
+func TestProgressbarPrinter_Stats(t *testing.T) {
+	p, _ := pterm.DefaultProgressbar.WithTotal(200).WithWriter(io.Discard).Start()
+	defer p.Stop()
+	time.Sleep(10 * time.Millisecond)
+	p.Add(50)
+
+	stats := p.Stats()
+	testza.AssertEqual(t, 50, stats.Current)
+	testza.AssertEqual(t, 200, stats.Total)
+	testza.AssertEqual(t, 25.0, stats.Percentage)
+	testza.AssertTrue(t, stats.Elapsed >= 10*time.Millisecond)
+	testza.AssertTrue(t, stats.Rate > 0)
+	testza.AssertTrue(t, stats.Rate <= 50/stats.Elapsed.Seconds())
+}
+
+func TestProgressbarPrinter_StatsNotStarted(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithTotal(0)
+
+	stats := p.Stats()
+	testza.AssertEqual(t, pterm.ProgressbarStats{}, stats)
+}
+
+func TestProgressbarPrinter_StatsConcurrent(t *testing.T) {
+	p, _ := pterm.DefaultProgressbar.WithTotal(1000).WithWriter(io.Discard).Start()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				p.Add(1)
+				stats := p.Stats()
+				testza.AssertTrue(t, stats.Current <= stats.Total)
+			}
+		}()
+	}
+	wg.Wait()
+
+	testza.AssertEqual(t, 1000, p.Stats().Current)
+}
+
+func TestProgressbarPrinter_WriteError(t *testing.T) {
+	p, err := pterm.DefaultProgressbar.WithTotal(10).WithWriter(failingWriter{}).Start()
+	testza.AssertNotNil(t, err)
+
+	p.Add(1)
+	testza.AssertNotNil(t, p.Err())
+
+	_, err = p.Stop()
+	testza.AssertNotNil(t, err)
+}
+
+func TestProgressbarPrinter_ErrWithoutError(t *testing.T) {
+	p, err := pterm.DefaultProgressbar.WithTotal(10).WithWriter(io.Discard).Start()
+	testza.AssertNoError(t, err)
+
+	p.Add(1)
+	testza.AssertNoError(t, p.Err())
+
+	_, err = p.Stop()
+	testza.AssertNoError(t, err)
+}
+
+func TestProgressbarPrinter_WithTitleWidth(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	testza.AssertEqual(t, 10, p.WithTitleWidth(10).TitleWidth)
+	testza.AssertEqual(t, 0, p.WithTitleWidth(-1).TitleWidth)
+}
+
+func TestProgressbarPrinter_TitleWidthAlignsBars(t *testing.T) {
+	for _, title := range []string{"a", "Downloading files", "中文标题很长很长"} {
+		var buf bytes.Buffer
+		p, _ := pterm.DefaultProgressbar.WithTitle(title).WithTitleWidth(10).WithShowCount(false).WithWriter(&buf).Start()
+		p.Add(50)
+		p.Stop()
+
+		lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+		line := lines[len(lines)-1]
+		testza.AssertEqual(t, 11, runewidth.StringWidth(line[:strings.Index(line, "█")]), title)
+	}
+}
+
+func TestProgressbarPrinter_TitleWidthTruncates(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTitle("Downloading files").WithTitleWidth(8).WithWriter(&buf).Start()
+	p.Stop()
+
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "Downloa…")
+}
+
+func TestProgressbarPrinter_WithCompactElapsedTime(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithCompactElapsedTime()
+
+	testza.AssertTrue(t, p2.CompactElapsedTime)
+	testza.AssertFalse(t, p.CompactElapsedTime)
+}
+
+func TestProgressbarPrinter_CompactElapsedTime(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithCompactElapsedTime().WithElapsedTimeRoundingFactor(time.Hour).WithWriter(&buf).Start()
+	p.Stop()
+
+	out := pterm.RemoveColorFromString(buf.String())
+	testza.AssertContains(t, out, "| 0s")
+	testza.AssertNotContains(t, out, "0h0m0s")
+}
+
+func TestProgressbarPrinter_PrintAboveBar(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithTitle("Working").WithShowElapsedTime(false).WithWriter(&buf).Start()
+	p.Add(5)
+	pterm.Fprint(&buf, "log message")
+	p.Stop()
+
+	out := pterm.RemoveColorFromString(buf.String())
+	lines := strings.Split(out, "\n")
+	testza.AssertLen(t, lines, 3)
+	// the message is printed on its own line and the bar is rendered below it
+	testza.AssertTrue(t, strings.HasSuffix(lines[0], "\rlog message"))
+	testza.AssertContains(t, lines[1], "Working [5/10]")
+	testza.AssertEqual(t, "", lines[2])
+}
+
+func TestProgressbarPrinter_PrintAboveBarConcurrent(t *testing.T) {
+	p, _ := pterm.DefaultProgressbar.WithTotal(100).WithWriter(io.Discard).Start()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			p.Add(1)
+		}()
+		go func() {
+			defer wg.Done()
+			pterm.Fprintln(io.Discard, "log message")
+		}()
+	}
+	wg.Wait()
+	p.Stop()
+
+	testza.AssertEqual(t, 10, p.Current)
+}
+
+func TestProgressbarPrinter_StartWhilePrinting(t *testing.T) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				pterm.Fprintln(io.Discard, "log message")
+			}
+		}
+	}()
+
+	for i := 0; i < 200; i++ {
+		p, err := pterm.DefaultProgressbar.WithTotal(10).WithWriter(io.Discard).Start()
+		testza.AssertNoError(t, err)
+		p.Stop()
+	}
+	close(done)
+	wg.Wait()
+}
+
+func TestProgressbarPrinter_PauseResume(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithTitle("Working").WithWriter(&buf).Start()
+	p.Add(1)
+
+	p.Pause()
+	testza.AssertTrue(t, p.IsPaused())
+	testza.AssertContains(t, buf.String(), "Working (paused)")
+	pausedElapsed := p.GetElapsedTime()
+	time.Sleep(50 * time.Millisecond)
+	testza.AssertEqual(t, pausedElapsed, p.GetElapsedTime())
+
+	p.Add(1)
+	testza.AssertEqual(t, 2, p.Current)
+
+	p.Resume()
+	testza.AssertFalse(t, p.IsPaused())
+	testza.AssertTrue(t, p.GetElapsedTime() < pausedElapsed+50*time.Millisecond)
+	testza.AssertTrue(t, p.Stats().Elapsed < 50*time.Millisecond)
+
+	p.Stop()
+	lines := strings.Split(strings.TrimSpace(pterm.RemoveColorFromString(buf.String())), "\r")
+	testza.AssertNotContains(t, lines[len(lines)-1], "(paused)")
+}
+
+func TestProgressbarPrinter_StopWhilePaused(t *testing.T) {
+	var buf Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10).WithTitle("Working").WithWriter(&buf).Start()
+	p.Pause()
+	p.Stop()
+
+	testza.AssertFalse(t, p.IsPaused())
+	lines := strings.Split(strings.TrimSpace(pterm.RemoveColorFromString(buf.String())), "\r")
+	testza.AssertNotContains(t, lines[len(lines)-1], "(paused)")
+}
+
+func TestProgressbarPrinter_PauseNotStarted(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithTotal(10)
+	p.Pause()
+	testza.AssertFalse(t, p.IsPaused())
+	p.Resume()
+}
+
+func TestProgressbarPrinter_WithBarFillerStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgGray)
+	p := pterm.DefaultProgressbar.WithBarFillerStyle(s)
+	testza.AssertEqual(t, s, p.BarFillerStyle)
+	testza.AssertNil(t, pterm.DefaultProgressbar.BarFillerStyle)
+}
+
+func TestProgressbarPrinter_WithLastCharacterStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultProgressbar.WithLastCharacterStyle(s)
+	testza.AssertEqual(t, s, p.LastCharacterStyle)
+	testza.AssertNil(t, pterm.DefaultProgressbar.LastCharacterStyle)
+}
+
+func TestProgressbarPrinter_SegmentStyles(t *testing.T) {
+	render := func(p *pterm.ProgressbarPrinter) string {
+		var buf Buffer
+		bar, _ := p.WithTotal(10).WithCurrent(4).WithMaxWidth(40).WithShowElapsedTime(false).WithWriter(&buf).Start()
+		bar.Stop()
+		return buf.String()
+	}
+	plain := render(pterm.DefaultProgressbar.WithBarCharacter("=").WithLastCharacter(">").WithBarFiller("-"))
+	styled := render(pterm.DefaultProgressbar.WithBarCharacter("=").WithLastCharacter(">").WithBarFiller("-").
+		WithBarFillerStyle(pterm.NewStyle(pterm.FgGray)).WithLastCharacterStyle(pterm.NewStyle(pterm.FgRed)))
+
+	testza.AssertEqual(t, pterm.RemoveColorFromString(plain), pterm.RemoveColorFromString(styled))
+	testza.AssertContains(t, styled, pterm.NewStyle(pterm.FgRed).Sprint(">"))
+	testza.AssertContains(t, styled, pterm.NewStyle(pterm.FgGray).Sprint("----------------"))
+	testza.AssertContains(t, plain, "\x1b[0m----------------")
+}
+
+func TestProgressbarPrinter_WithCompletedBarCharacter(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithCompletedBarCharacter("✓")
+	testza.AssertEqual(t, "✓", p.CompletedBarCharacter)
+	testza.AssertEqual(t, "", pterm.DefaultProgressbar.CompletedBarCharacter)
+}
+
+func TestProgressbarPrinter_WithCompletedBarStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgGreen)
+	p := pterm.DefaultProgressbar.WithCompletedBarStyle(s)
+	testza.AssertEqual(t, s, p.CompletedBarStyle)
+	testza.AssertNil(t, pterm.DefaultProgressbar.CompletedBarStyle)
+}
+
+func TestProgressbarPrinter_CompletedBar(t *testing.T) {
+	render := func(p *pterm.ProgressbarPrinter, current int) string {
+		var buf Buffer
+		bar, _ := p.WithTotal(10).WithCurrent(current).WithMaxWidth(40).WithShowElapsedTime(false).WithWriter(&buf).Start()
+		bar.Stop()
+		return buf.String()
+	}
+	base := pterm.DefaultProgressbar.WithBarCharacter("=").WithLastCharacter(">").WithBarFiller("-")
+	completed := base.WithCompletedBarCharacter("#").WithCompletedBarStyle(pterm.NewStyle(pterm.FgGreen))
+
+	// unset fields render the completed bar as before
+	testza.AssertEqual(t, render(base, 10), render(base.WithCompletedBarStyle(nil), 10))
+	testza.AssertContains(t, pterm.RemoveColorFromString(render(base, 10)), "==>")
+
+	done := render(completed, 10)
+	testza.AssertNotContains(t, pterm.RemoveColorFromString(done), "=")
+	testza.AssertContains(t, done, "\x1b[32m\x1b[32m###")
+	testza.AssertEqual(t, len(pterm.RemoveColorFromString(render(base, 10))), len(pterm.RemoveColorFromString(done)))
+
+	// the completed fill is only used when the progressbar is completed
+	testza.AssertEqual(t, render(base, 9), render(completed, 9))
+}
+
+func TestProgressbarPrinter_WithReverse(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithReverse()
+	testza.AssertTrue(t, p.Reverse)
+	testza.AssertFalse(t, pterm.DefaultProgressbar.Reverse)
+}
+
+func TestProgressbarPrinter_Reverse(t *testing.T) {
+	render := func(p *pterm.ProgressbarPrinter) string {
+		var buf Buffer
+		bar, _ := p.WithTotal(10).WithCurrent(4).WithMaxWidth(40).WithShowElapsedTime(false).WithBarCharacter("=").
+			WithLastCharacter(">").WithBarFiller("-").WithWriter(&buf).Start("タイトル")
+		bar.Stop()
+		return pterm.RemoveColorFromString(buf.String())
+	}
+	normal := render(&pterm.DefaultProgressbar)
+	reverse := render(pterm.DefaultProgressbar.WithReverse())
+
+	testza.AssertContains(t, normal, " =======>----------- ")
+	testza.AssertContains(t, reverse, " ----------->======= ")
+	testza.AssertEqual(t, runewidth.StringWidth(normal), runewidth.StringWidth(reverse))
+
+	styled := render(pterm.DefaultProgressbar.WithReverse().WithLastCharacterStyle(pterm.NewStyle(pterm.FgRed)))
+	testza.AssertEqual(t, reverse, styled)
+}
+
+func TestProgressbarPrinter_WithFixedWidth(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithFixedWidth(120)
+	testza.AssertEqual(t, 120, p.FixedWidth)
+	testza.AssertZero(t, pterm.DefaultProgressbar.FixedWidth)
+}
+
+func TestProgressbarPrinter_WithClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := pterm.DefaultProgressbar.WithClock(func() time.Time { return start })
+	testza.AssertEqual(t, start, p.Clock())
+	testza.AssertNil(t, pterm.DefaultProgressbar.Clock)
+}
+
+func TestProgressbarPrinter_DeterministicOutput(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithFixedWidth(50).WithBarCharacter("=").WithLastCharacter(">").
+		WithBarFiller("-").WithClock(func() time.Time { return now }).WithWriter(&buf).Start("Test")
+
+	now = now.Add(3 * time.Second)
+	bar.Add(5)
+	testza.AssertEqual(t, 3*time.Second, bar.GetElapsedTime())
+	bar.Stop()
+
+	lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+	testza.AssertEqual(t, "Test [0/10]  0% | 0s", lines[1])
+	testza.AssertEqual(t, "Test [5/10] ==============>-------------- 50% | 3s\n", lines[2])
+}
+
+func TestProgressbarPrinter_WithLogger(t *testing.T) {
+	logger := func(current, total int, title string) {}
+	p := pterm.DefaultProgressbar.WithLogger(logger)
+	testza.AssertNotNil(t, p.Logger)
+	testza.AssertNil(t, pterm.DefaultProgressbar.Logger)
+}
+
+func TestProgressbarPrinter_LoggerOnlyOnPercentageChange(t *testing.T) {
+	var calls [][2]int
+	var titles []string
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(1000).WithWriter(&buf).WithLogger(func(current, total int, title string) {
+		calls = append(calls, [2]int{current, total})
+		titles = append(titles, title)
+	}).Start("Logged")
+
+	for i := 0; i < 1000; i++ {
+		bar.Increment()
+	}
+	bar.Stop()
+
+	// one call per percentage from 0 to 100, and one on Stop
+	testza.AssertLen(t, calls, 102)
+	testza.AssertEqual(t, [2]int{0, 1000}, calls[0])
+	testza.AssertEqual(t, [2]int{1000, 1000}, calls[len(calls)-1])
+	testza.AssertEqual(t, "Logged", titles[0])
+	// the bar is rendered as well
+	testza.AssertContains(t, buf.String(), "Logged")
+}
+
+func TestProgressbarPrinter_LoggerOnStopBeforeCompletion(t *testing.T) {
+	var calls [][2]int
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithWriter(io.Discard).WithLogger(func(current, total int, title string) {
+		calls = append(calls, [2]int{current, total})
+	}).Start()
+
+	bar.Add(3)
+	bar.Stop()
+
+	testza.AssertEqual(t, [][2]int{{0, 10}, {3, 10}, {3, 10}}, calls)
+}
+
+func TestProgressbarPrinter_WideRuneTitleFillsWidth(t *testing.T) {
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithFixedWidth(40).WithBarCharacter("=").WithLastCharacter("=").
+		WithShowCount(false).WithShowPercentage(false).WithShowElapsedTime(false).WithWriter(&buf).Start("进度条")
+	bar.Add(10)
+	bar.Stop()
+
+	lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+	line := strings.TrimSuffix(lines[len(lines)-1], "\n")
+	// the title takes 6 columns, so the bar and its last character fill 32 of the 40 columns
+	testza.AssertEqual(t, "进度条 "+strings.Repeat("=", 32)+" ", line)
+	testza.AssertEqual(t, 40, runewidth.StringWidth(line))
+}
+
+func TestProgressbarPrinter_HugeTotalFillsMonotonically(t *testing.T) {
+	for _, total := range []int{math.MaxInt32 - 1, math.MaxInt - 1} {
+		var buf Buffer
+		bar, _ := pterm.DefaultProgressbar.WithTotal(total).WithFixedWidth(40).WithBarCharacter("=").WithLastCharacter("").
+			WithShowCount(false).WithShowPercentage(false).WithShowElapsedTime(false).WithShowTitle(false).WithWriter(&buf).Start()
+		step := total / 10
+		for i := 0; i < 10; i++ {
+			bar.Add(step)
+		}
+		bar.Add(total - bar.Current)
+		bar.Stop()
+
+		lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")[1:]
+		testza.AssertLen(t, lines, 12)
+		previous := 0
+		for _, line := range lines {
+			filled := strings.Count(line, "=")
+			testza.AssertTrue(t, filled >= previous, line)
+			testza.AssertTrue(t, filled <= 38, line)
+			previous = filled
+		}
+		testza.AssertEqual(t, 38, previous)
+	}
+}
+
+func TestProgressbarPrinter_CurrentAboveTotalIsClamped(t *testing.T) {
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithCurrent(25).WithFixedWidth(40).WithBarCharacter("=").WithLastCharacter("").
+		WithShowCount(false).WithShowPercentage(false).WithShowElapsedTime(false).WithShowTitle(false).WithWriter(&buf).Start()
+	bar.Stop()
+
+	testza.AssertContains(t, buf.String(), strings.Repeat("=", 38))
+	testza.AssertNotContains(t, buf.String(), strings.Repeat("=", 39))
+}
+
+func TestProgressbarPrinter_WithShowRate(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithShowRate()
+	testza.AssertTrue(t, p.ShowRate)
+	testza.AssertFalse(t, pterm.DefaultProgressbar.ShowRate)
+}
+
+func TestProgressbarPrinter_WithRateUnit(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithRateUnit("items")
+	testza.AssertEqual(t, "items", p.RateUnit)
+}
+
+func TestProgressbarPrinter_ShowRate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(100).WithFixedWidth(60).WithShowRate().WithRateUnit("items").
+		WithClock(func() time.Time { return now }).WithWriter(&buf).Start("Test")
+
+	now = now.Add(2 * time.Second)
+	bar.Add(20)
+	bar.Stop()
+
+	lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+	testza.AssertTrue(t, strings.HasSuffix(lines[1], "| 0s | --"), lines[1])
+	testza.AssertTrue(t, strings.HasSuffix(lines[2], "| 2s | 10.0 items/s\n"), lines[2])
+	// the width of the rate is taken into account
+	testza.AssertEqual(t, 60, runewidth.StringWidth(strings.TrimSuffix(lines[2], "\n")))
+}
+
+func TestProgressbarPrinter_ShowRateInBytes(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(100_000_000).WithShowElapsedTime(false).WithShowRate().WithRateUnit("B").
+		WithClock(func() time.Time { return now }).WithWriter(&buf).Start()
+
+	now = now.Add(2 * time.Second)
+	bar.Add(5_000_000)
+	bar.Stop()
+
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "| 2.5 MB/s")
+}
+
+func TestProgressbarPrinter_ShowRateIsAveragedOverRecentUpdates(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(1000).WithShowElapsedTime(false).WithShowRate().
+		WithClock(func() time.Time { return now }).WithWriter(&buf).Start()
+
+	// 100 per second for 10 seconds
+	for i := 0; i < 10; i++ {
+		now = now.Add(time.Second)
+		bar.Add(100)
+	}
+	// then 10 per second
+	for i := 0; i < 10; i++ {
+		now = now.Add(time.Second)
+		bar.Add(10)
+	}
+	bar.Stop()
+
+	lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+	testza.AssertContains(t, lines[10], "| 100.0/s")
+	// the old progress is not taken into account anymore
+	testza.AssertContains(t, lines[len(lines)-1], "| 10.0/s")
+}
+
+func TestProgressbarPrinter_WithSecondaryCurrent(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithSecondaryCurrent(5)
+	testza.AssertEqual(t, 5, p.SecondaryCurrent)
+	testza.AssertZero(t, pterm.DefaultProgressbar.SecondaryCurrent)
+}
+
+func TestProgressbarPrinter_WithSecondaryBarStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultProgressbar.WithSecondaryBarStyle(s)
+	testza.AssertEqual(t, s, p.SecondaryBarStyle)
+	testza.AssertEqual(t, &pterm.ThemeDefault.SecondaryStyle, pterm.DefaultProgressbar.SecondaryBarStyle)
+}
+
+func TestProgressbarPrinter_SecondaryProgress(t *testing.T) {
+	render := func(p *pterm.ProgressbarPrinter, secondary int) string {
+		var buf Buffer
+		bar, _ := p.WithTotal(10).WithCurrent(6).WithMaxWidth(40).WithShowElapsedTime(false).WithWriter(&buf).Start()
+		bar.UpdateSecondaryCurrent(secondary)
+		bar.Stop()
+		lines := strings.Split(buf.String(), "\r")
+		return lines[len(lines)-1]
+	}
+	base := pterm.DefaultProgressbar.WithBarCharacter("=").WithLastCharacter(">").
+		WithBarStyle(pterm.NewStyle(pterm.FgBlue)).WithSecondaryBarStyle(pterm.NewStyle(pterm.FgRed))
+
+	// without a secondary progress, the bar is rendered as before
+	testza.AssertNotContains(t, render(base, 0), "\x1b[31m")
+
+	testza.AssertContains(t, render(base, 3), "\x1b[31m\x1b[31m=======\x1b[0m\x1b[0m\x1b[34m\x1b[34m========>")
+	testza.AssertContains(t, render(base.WithReverse(), 3), "\x1b[34m\x1b[34m>========\x1b[0m\x1b[0m\x1b[31m\x1b[31m=======\x1b[0m")
+	// the secondary progress is never drawn beyond Current
+	testza.AssertContains(t, render(base, 20), "\x1b[31m\x1b[31m===============\x1b[0m\x1b[0m\x1b[34m\x1b[34m>")
+	testza.AssertEqual(t, len(pterm.RemoveColorFromString(render(base, 0))), len(pterm.RemoveColorFromString(render(base, 20))))
+}
+
+func TestProgressbarPrinter_OnlyWritesToItsWriter(t *testing.T) {
+	out, err := os.CreateTemp(t.TempDir(), "cursor")
+	testza.AssertNoError(t, err)
+	cursor.SetTarget(out)
+	defer cursor.SetTarget(os.Stdout)
+
+	var buf bytes.Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(2).WithActivityIndicator().WithWriter(&buf).Start("Test")
+	bar.Increment()
+	bar.Pause().Resume()
+	bar.Stop()
+
+	// no cursor codes are written, neither to the Writer, nor to the terminal
+	testza.AssertContains(t, buf.String(), "Test")
+	testza.AssertNotContains(t, buf.String(), "\x1b[?25")
+	content, _ := os.ReadFile(out.Name())
+	testza.AssertEqual(t, "", string(content))
+}
+
+func TestProgressbarPrinter_WithBarStart(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithBarStart("[")
+	testza.AssertEqual(t, "[", p.BarStart)
+	testza.AssertZero(t, pterm.DefaultProgressbar.BarStart)
+}
+
+func TestProgressbarPrinter_WithBarEnd(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithBarEnd("]")
+	testza.AssertEqual(t, "]", p.BarEnd)
+	testza.AssertZero(t, pterm.DefaultProgressbar.BarEnd)
+}
+
+func TestProgressbarPrinter_BarBoundaries(t *testing.T) {
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithFixedWidth(30).WithBarCharacter("=").WithLastCharacter(">").
+		WithBarFiller("-").WithBarStart("[").WithBarEnd("]").WithShowCount(false).WithShowElapsedTime(false).
+		WithWriter(&buf).Start("Test")
+	bar.Add(5)
+	bar.Add(5)
+	bar.Stop()
+
+	lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+	// the boundaries stay in place and the line still fits into the width
+	testza.AssertEqual(t, "Test [-------------------] 0% ", lines[1])
+	testza.AssertEqual(t, "Test [========>---------] 50% ", lines[2])
+	testza.AssertEqual(t, "Test [================>] 100% \n", lines[3])
+	for _, line := range lines[1:] {
+		testza.AssertEqual(t, 30, runewidth.StringWidth(strings.TrimSuffix(line, "\n")))
+	}
+}
+
+func TestProgressbarPrinter_BarBoundariesIndeterminate(t *testing.T) {
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithIndeterminate().WithFixedWidth(30).WithBarStart("[").WithBarEnd("]").
+		WithShowElapsedTime(false).WithWriter(&buf).Start("Test")
+	bar.Stop()
+
+	lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+	line := strings.TrimSuffix(lines[len(lines)-1], "\n")
+	testza.AssertTrue(t, strings.HasPrefix(line, "Test ["))
+	testza.AssertContains(t, line, "]")
+}
+
+func TestProgressbarPrinter_Srender(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithTotal(10).WithCurrent(5).WithFixedWidth(30).WithBarCharacter("=").WithLastCharacter(">").
+		WithBarFiller("-").WithShowElapsedTime(false).WithTitle("Test")
+	testza.AssertEqual(t, "Test [5/10] ======>------ 50% ", pterm.RemoveColorFromString(p.Srender()))
+	testza.AssertFalse(t, p.IsActive)
+}
+
+func TestProgressbarPrinter_SrenderDoesNotPrint(t *testing.T) {
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithFixedWidth(30).WithShowElapsedTime(false).WithWriter(&buf).Start("Test")
+	bar.Add(3)
+	written := buf.String()
+
+	lines := strings.Split(written, "\r")
+	testza.AssertEqual(t, lines[len(lines)-1], bar.Srender())
+	testza.AssertEqual(t, written, buf.String())
+	testza.AssertTrue(t, bar.IsActive)
+	bar.Stop()
+}
+
+func TestProgressbarPrinter_SrenderWithDefaultOptions(t *testing.T) {
+	p := pterm.DefaultProgressbar.WithTotal(10).WithCurrent(5).WithTitle("Test")
+	line := pterm.RemoveColorFromString(p.Srender())
+	testza.AssertTrue(t, strings.HasSuffix(line, "| 0s"))
+	testza.AssertEqual(t, line, pterm.RemoveColorFromString(p.Srender()))
+}
+
+func TestProgressbarPrinter_SrenderHasNoSideEffects(t *testing.T) {
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithTitleStyle(nil).WithBarStyle(nil).WithShowRate().
+		WithKeepTitleNewlines().WithWriter(&buf).Start("Head\nTail")
+	written := buf.String()
+
+	bar.Title = "Other head\nTail"
+	bar.Srender()
+	testza.AssertEqual(t, written, buf.String())
+	testza.AssertNil(t, bar.TitleStyle)
+	testza.AssertNil(t, bar.BarStyle)
+	bar.Stop()
+}
+
+func TestProgressbarPrinter_SrenderWithoutTotal(t *testing.T) {
+	testza.AssertEqual(t, "", pterm.DefaultProgressbar.WithTotal(0).Srender())
+}
+
+func TestProgressbarPrinter_SetTotal(t *testing.T) {
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithWriter(&buf).Start()
+	bar.Add(5)
+	bar.SetTotal(20)
+	testza.AssertEqual(t, 20, bar.Total)
+	testza.AssertEqual(t, 5, bar.Current)
+	testza.AssertTrue(t, bar.IsActive)
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "[5/20]")
+
+	bar.Add(15)
+	testza.AssertFalse(t, bar.IsActive)
+}
+
+func TestProgressbarPrinter_SetTotalBelowCurrentFinishes(t *testing.T) {
+	var buf Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithFixedWidth(40).WithWriter(&buf).Start()
+	bar.Add(8)
+	bar.SetTotal(5)
+	testza.AssertEqual(t, 5, bar.Current)
+	testza.AssertFalse(t, bar.IsActive)
+
+	lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+	line := strings.TrimSuffix(lines[len(lines)-1], "\n")
+	testza.AssertContains(t, line, "[5/5]")
+	testza.AssertEqual(t, 40, runewidth.StringWidth(line))
+}
+
+func TestProgressbarPrinter_SetTotalConcurrent(t *testing.T) {
+	bar, _ := pterm.DefaultProgressbar.WithTotal(1000).WithWriter(io.Discard).Start()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			bar.SetTotal(1000 + i)
+		}(i)
+		go func() {
+			defer wg.Done()
+			bar.Increment()
+		}()
+	}
+	wg.Wait()
+	bar.Stop()
+	testza.AssertEqual(t, 10, bar.Current)
+}
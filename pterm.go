@@ -7,6 +7,7 @@
 package pterm
 
 import (
+	"atomicgo.dev/cursor"
 	"github.com/gookit/color"
 	"go.uber.org/atomic"
 )
@@ -22,10 +23,29 @@ var (
 	// The variable indicates that PTerm will not add additional styling to text.
 	// Use pterm.DisableStyling() or pterm.EnableStyling() to change this variable.
 	RawOutput = atomic.NewBool(false)
+
+	// ManageCursor is set to false if pterm.SetCursorManagement(false) was called.
+	// The variable indicates that PTerm will not hide or show the terminal cursor.
+	ManageCursor = atomic.NewBool(true)
+
+	// cursorHidden is true, while the cursor is hidden by an interactive printer.
+	cursorHidden = atomic.NewBool(false)
+
+	// ForceTTY is set to true if pterm.SetForceTTY(true) was called.
+	// The variable indicates that PTerm treats the output as a terminal, even if it is redirected to a pipe or file.
+	// Setting the environment variable PTERM_FORCE_COLOR to a true value has the same effect.
+	ForceTTY = atomic.NewBool(false)
+
+	// ForceRawInput is set to true if pterm.SetForceRawInput(true) was called.
+	// The variable indicates that interactive printers read single key presses, even if stdin is not a terminal.
+	ForceRawInput = atomic.NewBool(false)
 )
 
 func init() {
 	color.ForceColor()
+	if forcedTTY() {
+		enableForcedColor()
+	}
 }
 
 // EnableOutput enables the output of PTerm.
@@ -63,8 +83,89 @@ func DisableStyling() {
 	DisableColor()
 }
 
+// SetCursorManagement sets if PTerm hides the cursor while interactive printers are shown.
+// Disable it, if PTerm is used inside another terminal UI, which manages the cursor itself.
+func SetCursorManagement(b bool) {
+	ManageCursor.Store(b)
+}
+
+// SetForceTTY sets if PTerm should treat the output as a terminal, even if it is not one.
+// This can be used to render colors and live printers into CI logs.
+// Colors are enabled, live printers redraw themselves like in a terminal,
+// and interactive printers read single key presses, if the terminal can be switched to raw mode.
+// An explicit disable, like pterm.DisableColor() or the NO_COLOR environment variable, still wins.
+func SetForceTTY(b bool) {
+	ForceTTY.Store(b)
+	if b {
+		enableForcedColor()
+	}
+}
+
+// SetForceRawInput sets if interactive printers should read single key presses, even if stdin is not a terminal.
+// By default, they fall back to reading whole lines in that case.
+// This can be used to simulate key presses with the keyboard package.
+func SetForceRawInput(b bool) {
+	ForceRawInput.Store(b)
+}
+
+// hideCursor hides the cursor, unless the cursor management is disabled.
+func hideCursor() {
+	if ManageCursor.Load() {
+		cursorHidden.Store(true)
+		cursor.Hide()
+	}
+}
+
+// showCursor shows the cursor, if it was hidden by hideCursor.
+func showCursor() {
+	if ManageCursor.Load() && cursorHidden.CompareAndSwap(true, false) {
+		cursor.Show()
+	}
+}
+
+// StopAll stops all active progressbars, spinners and areas, and shows the cursor again, if PTerm has hidden it.
+// It can be called from a signal handler, for example on SIGINT, so that the terminal is left in a clean state.
+// Calling it again, or while no live printer is active, does nothing.
+//
+// Example:
+//
+//	c := make(chan os.Signal, 1)
+//	signal.Notify(c, os.Interrupt)
+//	go func() {
+//		<-c
+//		pterm.StopAll()
+//		os.Exit(1)
+//	}()
+func StopAll() {
+	// The printers are stopped after the locks of the registries are released,
+	// because stopping a printer removes it from its registry.
+	activeProgressBarPrinters.lock.Lock()
+	bars := append([]*ProgressbarPrinter{}, activeProgressBarPrinters.printers...)
+	activeProgressBarPrinters.lock.Unlock()
+	for _, bar := range bars {
+		_, _ = bar.Stop()
+	}
+
+	activeSpinnerPrinters.lock.Lock()
+	spinners := append([]*SpinnerPrinter{}, activeSpinnerPrinters.printers...)
+	activeSpinnerPrinters.lock.Unlock()
+	for _, spinner := range spinners {
+		_ = spinner.Stop()
+	}
+
+	activeAreaPrinters.lock.Lock()
+	areas := append([]*AreaPrinter{}, activeAreaPrinters.printers...)
+	activeAreaPrinters.lock.Unlock()
+	for _, area := range areas {
+		_ = area.Stop()
+	}
+
+	showCursor()
+}
+
 // RecalculateTerminalSize updates already initialized terminal dimensions. Has to be called after a termina resize to guarantee proper rendering. Applies only to new instances.
 func RecalculateTerminalSize() {
+	invalidateTerminalSizeCache()
 	// keep in sync with DefaultBarChart
 	DefaultBarChart.Width = GetTerminalWidth() * 2 / 3
 	DefaultBarChart.Height = GetTerminalHeight() * 2 / 3
@@ -1,7 +1,9 @@
 package pterm_test
 
 import (
+	"io"
 	"testing"
+	"time"
 
 	"github.com/MarvinJWendt/testza"
 	"github.com/pterm/pterm"
@@ -41,6 +43,13 @@ func TestEnableStyling(t *testing.T) {
 	testza.AssertFalse(t, pterm.RawOutput.Load())
 }
 
+func TestSetCursorManagement(t *testing.T) {
+	pterm.SetCursorManagement(false)
+	testza.AssertFalse(t, pterm.ManageCursor.Load())
+	pterm.SetCursorManagement(true)
+	testza.AssertTrue(t, pterm.ManageCursor.Load())
+}
+
 func TestInterfaceImplementation(t *testing.T) {
 	// If a printer doesn't fit into the slice, the printer doesn't has the right interface anymore.
 	_ = []pterm.TextPrinter{&pterm.DefaultBasicText, pterm.DefaultBox, pterm.DefaultCenter, &pterm.DefaultHeader, &pterm.DefaultParagraph, &pterm.Info, &pterm.DefaultSection, pterm.FgRed, pterm.NewRGB(0, 0, 0)}
@@ -64,3 +73,49 @@ func TestRecalculateTerminalSize(t *testing.T) {
 	// revert the terminal size
 	pterm.SetForcedTerminalSize(w, h)
 }
+
+func TestStopAll(t *testing.T) {
+	bar, _ := pterm.DefaultProgressbar.WithWriter(io.Discard).Start()
+	spinner, _ := pterm.DefaultSpinner.WithWriter(io.Discard).Start()
+	area, _ := pterm.DefaultArea.Start()
+
+	pterm.StopAll()
+
+	testza.AssertFalse(t, bar.IsActive)
+	testza.AssertFalse(t, spinner.IsActive)
+	// stopping the printers again is a no-op
+	_, err := bar.Stop()
+	testza.AssertNoError(t, err)
+	testza.AssertNoError(t, spinner.Stop())
+	testza.AssertNoError(t, area.Stop())
+}
+
+func TestStopAll_Idempotent(t *testing.T) {
+	bar, _ := pterm.DefaultProgressbar.WithWriter(io.Discard).Start()
+
+	pterm.StopAll()
+	pterm.StopAll()
+
+	testza.AssertFalse(t, bar.IsActive)
+}
+
+func TestStopAll_WhilePrintersAreUpdated(t *testing.T) {
+	bar, _ := pterm.DefaultProgressbar.WithTotal(1000000).WithWriter(io.Discard).Start()
+	spinner, _ := pterm.DefaultSpinner.WithDelay(time.Millisecond).WithWriter(io.Discard).Start()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			bar.Increment()
+			spinner.UpdateText("working")
+			pterm.Fprintln(io.Discard, "log line")
+		}
+	}()
+
+	pterm.StopAll()
+	<-done
+
+	testza.AssertFalse(t, bar.IsActive)
+	testza.AssertFalse(t, spinner.IsActive)
+}
@@ -0,0 +1,71 @@
+package putils
+
+import (
+	"math"
+
+	"github.com/pterm/pterm"
+)
+
+// BarValue is a labeled value, which is shown as a bar in a bar chart.
+type BarValue struct {
+	Label string
+	Value float64
+	// Color is the color of the bar. If it is nil, the bar style of the theme is used.
+	Color *pterm.RGB
+}
+
+// barValueRange is the largest absolute integer value, which fractional values are scaled to.
+const barValueRange = 1_000_000
+
+// BarsFromValues converts labeled values into pterm.Bars.
+// Bar charts use integer values, so whole values are kept as they are.
+// If a value has a fraction, or doesn't fit into an int32, all values are scaled,
+// so that the largest absolute value becomes 1,000,000 and the proportions of the bars are kept.
+// The shown values are then the scaled values. NaN and infinite values become zero.
+func BarsFromValues(values []BarValue) pterm.Bars {
+	scale := barValueScale(values)
+	bars := make(pterm.Bars, 0, len(values))
+	for _, value := range values {
+		bar := pterm.Bar{
+			Label: value.Label,
+		}
+		if !math.IsNaN(value.Value) && !math.IsInf(value.Value, 0) {
+			bar.Value = int(math.Round(value.Value * scale))
+		}
+		if value.Color != nil {
+			bar.Style = value.Color.ToStyle()
+		}
+		bars = append(bars, bar)
+	}
+	return bars
+}
+
+// barValueScale returns the factor, which scales the values into integers.
+func barValueScale(values []BarValue) float64 {
+	var maxAbs float64
+	whole := true
+	for _, value := range values {
+		if math.IsNaN(value.Value) || math.IsInf(value.Value, 0) {
+			continue
+		}
+		maxAbs = math.Max(maxAbs, math.Abs(value.Value))
+		if value.Value != math.Trunc(value.Value) {
+			whole = false
+		}
+	}
+	if maxAbs == 0 || (whole && maxAbs <= math.MaxInt32) {
+		return 1
+	}
+	return barValueRange / maxAbs
+}
+
+// BarChartFromValues returns a horizontal BarChartPrinter, which shows the labeled values.
+// The bars are scaled to the largest value, and negative values are drawn to the left of the zero baseline.
+// Use WithHorizontal(false) for vertical bars.
+//
+// Usage:
+//
+//	putils.BarChartFromValues([]putils.BarValue{{Label: "Go", Value: 42}, {Label: "Rust", Value: 17}}).WithShowValue().Render()
+func BarChartFromValues(values []BarValue) *pterm.BarChartPrinter {
+	return pterm.DefaultBarChart.WithHorizontal().WithBars(BarsFromValues(values))
+}
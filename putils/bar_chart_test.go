@@ -0,0 +1,53 @@
+package putils
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestBarsFromValues(t *testing.T) {
+	red := pterm.NewRGB(255, 0, 0)
+	bars := BarsFromValues([]BarValue{{Label: "a", Value: 1.6}, {Label: "b", Value: -2.4, Color: &red}})
+
+	testza.AssertEqual(t, pterm.Bars{
+		{Label: "a", Value: 666667},
+		{Label: "b", Value: -1000000, Style: red.ToStyle()},
+	}, bars)
+}
+
+func TestBarsFromValues_Whole(t *testing.T) {
+	bars := BarsFromValues([]BarValue{{Label: "a", Value: 3}, {Label: "b", Value: -1}, {Label: "c", Value: math.NaN()}})
+
+	testza.AssertEqual(t, pterm.Bars{
+		{Label: "a", Value: 3},
+		{Label: "b", Value: -1},
+		{Label: "c", Value: 0},
+	}, bars)
+}
+
+func TestBarsFromValues_Fractions(t *testing.T) {
+	bars := BarsFromValues([]BarValue{{Label: "a", Value: 0.2}, {Label: "b", Value: 0.4}, {Label: "c", Value: 1e12}})
+
+	testza.AssertEqual(t, 0, bars[0].Value)
+	testza.AssertEqual(t, 1000000, bars[2].Value)
+
+	bars = BarsFromValues([]BarValue{{Label: "a", Value: 0.2}, {Label: "b", Value: 0.4}})
+	testza.AssertEqual(t, 500000, bars[0].Value)
+	testza.AssertEqual(t, 1000000, bars[1].Value)
+}
+
+func TestBarChartFromValues(t *testing.T) {
+	chart := BarChartFromValues([]BarValue{{Label: "a", Value: 3}, {Label: "bbb", Value: -1}}).WithWidth(4).WithShowValue()
+	testza.AssertTrue(t, chart.Horizontal)
+
+	out, err := chart.Srender()
+	testza.AssertNoError(t, err)
+	out = pterm.RemoveColorFromString(out)
+	testza.AssertContains(t, out, "a  ")
+	testza.AssertContains(t, out, "  3")
+	testza.AssertContains(t, out, " -1")
+}
@@ -29,3 +29,43 @@ func BulletListItemFromString(text string, padding string) pterm.BulletListItem
 func BulletListFromString(s string, padding string) pterm.BulletListPrinter {
 	return BulletListFromStrings(strings.Split(s, "\n"), padding)
 }
+
+// BulletListFromTreeNode flattens a TreeNode into BulletListItems, which can be used in a BulletListPrinter.
+// The Level of an item is the depth of the node in the tree. If the root node has a Text, it will be the first item.
+// Optionally, a bullet per level can be passed. Levels deeper than the passed bullets use the last bullet.
+func BulletListFromTreeNode(root pterm.TreeNode, bullets ...string) []pterm.BulletListItem {
+	var items []pterm.BulletListItem
+	level := 0
+	if root.Text != "" {
+		items = append(items, bulletListItemFromTreeNode(root, level, bullets))
+		level++
+	}
+
+	return append(items, bulletListItemsFromTreeNodes(root.Children, level, bullets)...)
+}
+
+func bulletListItemsFromTreeNodes(nodes []pterm.TreeNode, level int, bullets []string) []pterm.BulletListItem {
+	var items []pterm.BulletListItem
+	for _, node := range nodes {
+		items = append(items, bulletListItemFromTreeNode(node, level, bullets))
+		items = append(items, bulletListItemsFromTreeNodes(node.Children, level+1, bullets)...)
+	}
+
+	return items
+}
+
+func bulletListItemFromTreeNode(node pterm.TreeNode, level int, bullets []string) pterm.BulletListItem {
+	item := pterm.BulletListItem{
+		Level: level,
+		Text:  node.Text,
+	}
+	if len(bullets) > 0 {
+		if level < len(bullets) {
+			item.Bullet = bullets[level]
+		} else {
+			item.Bullet = bullets[len(bullets)-1]
+		}
+	}
+
+	return item
+}
@@ -0,0 +1,60 @@
+package putils
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestBulletListFromTreeNode(t *testing.T) {
+	root := pterm.TreeNode{
+		Children: []pterm.TreeNode{
+			{Text: "0", Children: []pterm.TreeNode{
+				{Text: "0.0", Children: []pterm.TreeNode{{Text: "0.0.0"}}},
+				{Text: "0.1"},
+			}},
+			{Text: "1"},
+		},
+	}
+
+	expected := []pterm.BulletListItem{
+		{Level: 0, Text: "0"},
+		{Level: 1, Text: "0.0"},
+		{Level: 2, Text: "0.0.0"},
+		{Level: 1, Text: "0.1"},
+		{Level: 0, Text: "1"},
+	}
+
+	testza.AssertEqual(t, expected, BulletListFromTreeNode(root))
+}
+
+func TestBulletListFromTreeNodeWithRootText(t *testing.T) {
+	root := pterm.TreeNode{Text: "root", Children: []pterm.TreeNode{{Text: "child"}}}
+
+	expected := []pterm.BulletListItem{
+		{Level: 0, Text: "root"},
+		{Level: 1, Text: "child"},
+	}
+
+	testza.AssertEqual(t, expected, BulletListFromTreeNode(root))
+}
+
+func TestBulletListFromTreeNodeWithBullets(t *testing.T) {
+	root := pterm.TreeNode{
+		Children: []pterm.TreeNode{
+			{Text: "0", Children: []pterm.TreeNode{
+				{Text: "0.0", Children: []pterm.TreeNode{{Text: "0.0.0"}}},
+			}},
+		},
+	}
+
+	expected := []pterm.BulletListItem{
+		{Level: 0, Text: "0", Bullet: "•"},
+		{Level: 1, Text: "0.0", Bullet: "-"},
+		{Level: 2, Text: "0.0.0", Bullet: "-"},
+	}
+
+	testza.AssertEqual(t, expected, BulletListFromTreeNode(root, "•", "-"))
+}
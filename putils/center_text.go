@@ -1,8 +1,45 @@
 package putils
 
-import "github.com/pterm/pterm/internal"
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/pterm/pterm"
+	"github.com/pterm/pterm/internal"
+)
 
 // CenterText returns a centered string with each line centered in respect to the longest line.
 func CenterText(text string) string {
 	return internal.CenterText(text, 0)
 }
+
+// CenterBlock returns the text centered as a block within termWidth.
+// Every line gets the same left padding, so the alignment of the lines to each other is preserved.
+// If termWidth is zero, or below, the terminal width is used.
+// If the block is wider than termWidth, no padding is added.
+func CenterBlock(text string, termWidth int) string {
+	if termWidth <= 0 {
+		termWidth = pterm.GetTerminalWidth()
+	}
+
+	lines := strings.Split(text, "\n")
+	var maxWidth int
+	for _, line := range lines {
+		if w := runewidth.StringWidth(pterm.RemoveColorFromString(line)); w > maxWidth {
+			maxWidth = w
+		}
+	}
+
+	padding := (termWidth - maxWidth) / 2
+	if padding <= 0 {
+		return text
+	}
+
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = strings.Repeat(" ", padding) + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -9,3 +9,15 @@ import (
 func TestCenterText(t *testing.T) {
 	testza.AssertEqual(t, "Hello Wolrd\n    !!!    ", CenterText("Hello Wolrd\n!!!"))
 }
+
+func TestCenterBlock(t *testing.T) {
+	testza.AssertEqual(t, "   +--+\n   |  |\n   +--+", CenterBlock("+--+\n|  |\n+--+", 10))
+}
+
+func TestCenterBlock_KeepsAlignment(t *testing.T) {
+	testza.AssertEqual(t, "  Hello World\n  !!!\n\n  ok", CenterBlock("Hello World\n!!!\n\nok", 15))
+}
+
+func TestCenterBlock_TooWide(t *testing.T) {
+	testza.AssertEqual(t, "Hello World", CenterBlock("Hello World", 5))
+}
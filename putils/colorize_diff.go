@@ -0,0 +1,88 @@
+package putils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+// hunkHeaderRegex matches the header of a hunk, like "@@ -1,3 +1,4 @@", and captures the line counts.
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+\d+(?:,(\d+))? @@`)
+
+// ColorizeDiff returns a unified diff, colorized with the styles of pterm.ThemeDefault.
+// Added lines are green, removed lines are red, context lines are dimmed and hunk headers are cyan.
+// File headers, like "--- a/file" and "+++ b/file", are bold, so that they are not confused with removed and added lines.
+// If colors are disabled, the diff is returned unchanged.
+func ColorizeDiff(diff string) string {
+	lines := strings.Split(diff, "\n")
+	headerStyle := pterm.NewStyle(pterm.Bold)
+
+	// the remaining lines of the current hunk, which are counted to tell file headers from changed lines
+	var oldLines, newLines int
+	var hunks bool
+	for i, line := range lines {
+		inHunk := oldLines > 0 || newLines > 0
+		switch {
+		case line == "":
+			if inHunk {
+				// some tools strip the space of empty context lines
+				oldLines--
+				newLines--
+			}
+		case strings.HasPrefix(line, `\`):
+			// "\ No newline at end of file"
+			lines[i] = pterm.ThemeDefault.DebugMessageStyle.Sprint(line)
+		case inHunk && strings.HasPrefix(line, "+"):
+			newLines--
+			lines[i] = pterm.ThemeDefault.SuccessMessageStyle.Sprint(line)
+		case inHunk && strings.HasPrefix(line, "-"):
+			oldLines--
+			lines[i] = pterm.ThemeDefault.ErrorMessageStyle.Sprint(line)
+		case inHunk && strings.HasPrefix(line, " "):
+			oldLines--
+			newLines--
+			lines[i] = pterm.ThemeDefault.DebugMessageStyle.Sprint(line)
+		case strings.HasPrefix(line, "@@"):
+			hunks = true
+			oldLines, newLines = parseHunkHeader(line)
+			lines[i] = pterm.ThemeDefault.InfoMessageStyle.Sprint(line)
+		case strings.HasPrefix(line, "+++ ") || strings.HasPrefix(line, "--- "):
+			lines[i] = headerStyle.Sprint(line)
+		case !hunks && strings.HasPrefix(line, "+"):
+			// a diff without hunk headers is colorized by the prefixes only
+			lines[i] = pterm.ThemeDefault.SuccessMessageStyle.Sprint(line)
+		case !hunks && strings.HasPrefix(line, "-"):
+			lines[i] = pterm.ThemeDefault.ErrorMessageStyle.Sprint(line)
+		case strings.HasPrefix(line, " "):
+			lines[i] = pterm.ThemeDefault.DebugMessageStyle.Sprint(line)
+		default:
+			// other lines outside of hunks, like "diff --git a/file b/file" or "index 1234567..89abcde"
+			lines[i] = headerStyle.Sprint(line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// parseHunkHeader returns the line counts of the old and the new file of a hunk header.
+// A missing count is 1, as defined by the unified diff format.
+func parseHunkHeader(line string) (oldLines, newLines int) {
+	match := hunkHeaderRegex.FindStringSubmatch(line)
+	if match == nil {
+		return 0, 0
+	}
+	return parseHunkCount(match[1]), parseHunkCount(match[2])
+}
+
+func parseHunkCount(s string) int {
+	if s == "" {
+		return 1
+	}
+	count, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return count
+}
@@ -0,0 +1,66 @@
+package putils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+const testDiff = `diff --git a/config.yaml b/config.yaml
+index 83db48f..bf269f4 100644
+--- a/config.yaml
++++ b/config.yaml
+@@ -1,3 +1,3 @@
+ name: app
+--- removed separator
++++ added separator
+ port: 8080
+\ No newline at end of file
+`
+
+func TestColorizeDiff(t *testing.T) {
+	lines := strings.Split(ColorizeDiff(testDiff), "\n")
+	header := pterm.NewStyle(pterm.Bold)
+
+	testza.AssertEqual(t, header.Sprint("diff --git a/config.yaml b/config.yaml"), lines[0])
+	testza.AssertEqual(t, header.Sprint("index 83db48f..bf269f4 100644"), lines[1])
+	testza.AssertEqual(t, header.Sprint("--- a/config.yaml"), lines[2])
+	testza.AssertEqual(t, header.Sprint("+++ b/config.yaml"), lines[3])
+	testza.AssertEqual(t, pterm.ThemeDefault.InfoMessageStyle.Sprint("@@ -1,3 +1,3 @@"), lines[4])
+	testza.AssertEqual(t, pterm.ThemeDefault.DebugMessageStyle.Sprint(" name: app"), lines[5])
+	// changed lines, which look like file headers, are detected by counting the lines of the hunk
+	testza.AssertEqual(t, pterm.ThemeDefault.ErrorMessageStyle.Sprint("--- removed separator"), lines[6])
+	testza.AssertEqual(t, pterm.ThemeDefault.SuccessMessageStyle.Sprint("+++ added separator"), lines[7])
+	testza.AssertEqual(t, pterm.ThemeDefault.DebugMessageStyle.Sprint(" port: 8080"), lines[8])
+	testza.AssertEqual(t, pterm.ThemeDefault.DebugMessageStyle.Sprint(`\ No newline at end of file`), lines[9])
+	testza.AssertEqual(t, "", lines[10])
+}
+
+func TestColorizeDiff_MultipleFiles(t *testing.T) {
+	diff := "--- a/a.txt\n+++ b/a.txt\n@@ -1 +1 @@\n-a\n+b\n--- a/b.txt\n+++ b/b.txt\n@@ -1 +1 @@\n-c\n+d"
+	lines := strings.Split(ColorizeDiff(diff), "\n")
+	header := pterm.NewStyle(pterm.Bold)
+
+	testza.AssertEqual(t, pterm.ThemeDefault.ErrorMessageStyle.Sprint("-a"), lines[3])
+	testza.AssertEqual(t, pterm.ThemeDefault.SuccessMessageStyle.Sprint("+b"), lines[4])
+	testza.AssertEqual(t, header.Sprint("--- a/b.txt"), lines[5])
+	testza.AssertEqual(t, header.Sprint("+++ b/b.txt"), lines[6])
+	testza.AssertEqual(t, pterm.ThemeDefault.SuccessMessageStyle.Sprint("+d"), lines[9])
+}
+
+func TestColorizeDiff_WithoutHunkHeaders(t *testing.T) {
+	lines := strings.Split(ColorizeDiff("-old\n+new\n same"), "\n")
+
+	testza.AssertEqual(t, pterm.ThemeDefault.ErrorMessageStyle.Sprint("-old"), lines[0])
+	testza.AssertEqual(t, pterm.ThemeDefault.SuccessMessageStyle.Sprint("+new"), lines[1])
+	testza.AssertEqual(t, pterm.ThemeDefault.DebugMessageStyle.Sprint(" same"), lines[2])
+}
+
+func TestColorizeDiff_PlainWithoutColor(t *testing.T) {
+	pterm.DisableColor()
+	defer pterm.EnableColor()
+
+	testza.AssertEqual(t, testDiff, ColorizeDiff(testDiff))
+}
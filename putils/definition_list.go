@@ -0,0 +1,70 @@
+package putils
+
+import (
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/pterm/pterm/internal"
+)
+
+// DefinitionListOptions configures the layout of DefinitionList.
+type DefinitionListOptions struct {
+	// Width is the maximum width of the list. If it is zero, the terminal width is used.
+	Width int
+	// KeyStyle is the style of the keys. If it is nil, the primary style of the theme is used.
+	KeyStyle *pterm.Style
+	// Placeholder is shown instead of empty values. If it is empty, "-" is used.
+	Placeholder string
+}
+
+// DefinitionList renders key/value pairs in two columns.
+// The keys are right-aligned, so that the colons line up, and the values are wrapped to the remaining width.
+// Wrapped lines of a value are indented under the value column. Empty values are shown as a dim placeholder.
+//
+// Usage:
+//
+//	pterm.Println(putils.DefinitionList([][2]string{{"Name", "pterm"}, {"License", "MIT"}}, putils.DefinitionListOptions{}))
+func DefinitionList(pairs [][2]string, opts DefinitionListOptions) string {
+	width := opts.Width
+	if width <= 0 {
+		width = pterm.GetTerminalWidth()
+	}
+	keyStyle := opts.KeyStyle
+	if keyStyle == nil {
+		keyStyle = &pterm.ThemeDefault.PrimaryStyle
+	}
+	placeholder := opts.Placeholder
+	if placeholder == "" {
+		placeholder = "-"
+	}
+
+	var keyWidth int
+	for _, pair := range pairs {
+		if w := internal.DisplayWidth(pair[0]); w > keyWidth {
+			keyWidth = w
+		}
+	}
+	indent := strings.Repeat(" ", keyWidth+2)
+
+	var lines []string
+	for _, pair := range pairs {
+		key := strings.Repeat(" ", keyWidth-internal.DisplayWidth(pair[0])) + keyStyle.Sprint(pair[0]) + ": "
+		if strings.TrimSpace(pair[1]) == "" {
+			lines = append(lines, key+pterm.Gray(placeholder))
+			continue
+		}
+
+		for i, line := range strings.Split(WrapText(strings.TrimSpace(pair[1]), width-keyWidth-2, WrapOptions{}), "\n") {
+			switch {
+			case i == 0:
+				lines = append(lines, key+line)
+			case line == "":
+				lines = append(lines, "")
+			default:
+				lines = append(lines, indent+line)
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
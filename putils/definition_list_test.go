@@ -0,0 +1,35 @@
+package putils
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+func TestDefinitionList(t *testing.T) {
+	pairs := [][2]string{{"Name", "pterm"}, {"Description", "A modern library to beautify console output"}}
+	expected := "       Name: pterm\n" +
+		"Description: A modern\n" +
+		"             library to\n" +
+		"             beautify\n" +
+		"             console\n" +
+		"             output"
+	testza.AssertEqual(t, expected, pterm.RemoveColorFromString(DefinitionList(pairs, DefinitionListOptions{Width: 24})))
+}
+
+func TestDefinitionList_KeyStyle(t *testing.T) {
+	style := pterm.NewStyle(pterm.FgRed)
+	result := DefinitionList([][2]string{{"Key", "Value"}}, DefinitionListOptions{Width: 40, KeyStyle: style})
+	testza.AssertEqual(t, style.Sprint("Key")+": Value", result)
+}
+
+func TestDefinitionList_EmptyValue(t *testing.T) {
+	testza.AssertEqual(t, "Key: "+pterm.Gray("-"), DefinitionList([][2]string{{"Key", " "}}, DefinitionListOptions{Width: 40, KeyStyle: pterm.NewStyle()}))
+	testza.AssertEqual(t, "Key: "+pterm.Gray("n/a"), DefinitionList([][2]string{{"Key", ""}}, DefinitionListOptions{Width: 40, KeyStyle: pterm.NewStyle(), Placeholder: "n/a"}))
+}
+
+func TestDefinitionList_KeepsParagraphs(t *testing.T) {
+	result := DefinitionList([][2]string{{"A", "first\n\nsecond"}}, DefinitionListOptions{Width: 40, KeyStyle: pterm.NewStyle()})
+	testza.AssertEqual(t, "A: first\n\n   second", result)
+}
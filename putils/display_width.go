@@ -0,0 +1,19 @@
+package putils
+
+import "github.com/pterm/pterm/internal"
+
+// DisplayWidth returns the number of columns, which the string takes up in a terminal.
+// Double-width runes, like CJK characters, count as 2. Color codes and hyperlinks are ignored.
+func DisplayWidth(s string) int {
+	return internal.DisplayWidth(s)
+}
+
+// Truncate shortens the string to the given display width and appends the ellipsis, if the string was shortened.
+// The string is never cut inside of a double-width rune or an escape sequence, and color codes and hyperlinks are kept.
+//
+// Usage:
+//
+//	putils.Truncate(pterm.Red("Hello World"), 8, "…") // "Hello W…" in red
+func Truncate(s string, width int, ellipsis string) string {
+	return internal.TruncateString(s, width, ellipsis)
+}
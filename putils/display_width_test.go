@@ -0,0 +1,35 @@
+package putils
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	testza.AssertEqual(t, 5, DisplayWidth("Hello"))
+	testza.AssertEqual(t, 8, DisplayWidth("你好世界"))
+	testza.AssertEqual(t, 5, DisplayWidth(pterm.Red("Hello")))
+	testza.AssertEqual(t, 4, DisplayWidth("\x1b]8;;https://pterm.sh\x1b\\link\x1b]8;;\x1b\\"))
+	testza.AssertEqual(t, 4, DisplayWidth("\x1b]8;;https://pterm.sh\alink\x1b]8;;\a"))
+	testza.AssertEqual(t, 0, DisplayWidth(""))
+}
+
+func TestTruncate(t *testing.T) {
+	testza.AssertEqual(t, "Hello", Truncate("Hello", 5, "…"))
+	testza.AssertEqual(t, "Hell…", Truncate("Hello World", 5, "…"))
+	testza.AssertEqual(t, "", Truncate("Hello", 0, "…"))
+	// double-width runes are not split
+	testza.AssertEqual(t, "你…", Truncate("你好世界", 4, "…"))
+	testza.AssertEqual(t, "你好", Truncate("你好世界", 5, ""))
+}
+
+func TestTruncate_KeepsEscapeSequences(t *testing.T) {
+	s := Truncate("\x1b[31mHello World\x1b[0m", 5, "…")
+	testza.AssertEqual(t, "\x1b[31mHell…\x1b[0m", s)
+
+	link := "\x1b]8;;https://pterm.sh\x1b\\Hello World\x1b]8;;\x1b\\"
+	testza.AssertEqual(t, "\x1b]8;;https://pterm.sh\x1b\\Hell…\x1b]8;;\x1b\\", Truncate(link, 5, "…"))
+	testza.AssertEqual(t, link, Truncate(link, 11, "…"))
+}
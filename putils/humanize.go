@@ -0,0 +1,24 @@
+package putils
+
+import (
+	"time"
+
+	"github.com/pterm/pterm/internal"
+)
+
+// FormatDuration returns a compact representation of the duration, like "1h2m3s".
+// Zero units are left out, so one hour is returned as "1h". Durations below one second are returned in milliseconds.
+// The ProgressbarPrinter uses the same format, if CompactElapsedTime is set.
+func FormatDuration(d time.Duration) string {
+	return internal.FormatDuration(d)
+}
+
+// FormatBytes returns the byte count in binary (IEC) units, like "1.5 KiB".
+func FormatBytes(n int64) string {
+	return internal.FormatBytes(n, false)
+}
+
+// FormatBytesSI returns the byte count in decimal (SI) units, like "1.5 kB".
+func FormatBytesSI(n int64) string {
+	return internal.FormatBytes(n, true)
+}
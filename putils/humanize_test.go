@@ -0,0 +1,20 @@
+package putils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+)
+
+func TestFormatDuration(t *testing.T) {
+	testza.AssertEqual(t, "1h2m3s", FormatDuration(time.Hour+2*time.Minute+3*time.Second))
+}
+
+func TestFormatBytes(t *testing.T) {
+	testza.AssertEqual(t, "1.5 KiB", FormatBytes(1536))
+}
+
+func TestFormatBytesSI(t *testing.T) {
+	testza.AssertEqual(t, "1.5 kB", FormatBytesSI(1500))
+}
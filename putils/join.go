@@ -0,0 +1,121 @@
+package putils
+
+import (
+	"strings"
+
+	"github.com/pterm/pterm/internal"
+)
+
+// Alignment defines how blocks are aligned by JoinVertical.
+type Alignment int
+
+const (
+	// AlignLeft aligns the blocks to the left.
+	AlignLeft Alignment = iota
+	// AlignCenter centers the blocks.
+	AlignCenter
+	// AlignRight aligns the blocks to the right.
+	AlignRight
+)
+
+// JoinHorizontal places pre-rendered blocks, like boxes or tables, next to each other, separated by two spaces.
+// The tops of the blocks are aligned, and shorter blocks are padded with empty lines.
+//
+// Usage:
+//
+//	box1 := pterm.DefaultBox.Sprint("Hello")
+//	box2 := pterm.DefaultBox.Sprint("World")
+//	pterm.Println(putils.JoinHorizontal(box1, box2))
+func JoinHorizontal(blocks ...string) string {
+	return JoinHorizontalWithGutter("  ", blocks...)
+}
+
+// JoinHorizontalWithGutter places pre-rendered blocks next to each other, like JoinHorizontal, separated by the gutter.
+// The widths of the blocks are measured without color codes, and double-width runes count as 2.
+func JoinHorizontalWithGutter(gutter string, blocks ...string) string {
+	if len(blocks) == 0 {
+		return ""
+	}
+
+	lines := make([][]string, len(blocks))
+	widths := make([]int, len(blocks))
+	height := 0
+	for i, block := range blocks {
+		lines[i] = blockLines(block)
+		widths[i] = blockWidth(lines[i])
+		if len(lines[i]) > height {
+			height = len(lines[i])
+		}
+	}
+
+	rows := make([]string, height)
+	for row := range rows {
+		var sb strings.Builder
+		for i := range blocks {
+			var line string
+			if row < len(lines[i]) {
+				line = lines[i][row]
+			}
+			if i > 0 {
+				sb.WriteString(gutter)
+			}
+			sb.WriteString(line)
+			// the last block is not padded, so that no trailing spaces are added
+			if i < len(blocks)-1 {
+				sb.WriteString(strings.Repeat(" ", widths[i]-internal.DisplayWidth(line)))
+			}
+		}
+		rows[row] = sb.String()
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// JoinVertical stacks pre-rendered blocks on top of each other.
+// Every block is aligned as a whole within the width of the widest block, so that the lines of a block keep their alignment.
+func JoinVertical(alignment Alignment, blocks ...string) string {
+	lines := make([][]string, len(blocks))
+	width := 0
+	for i, block := range blocks {
+		lines[i] = blockLines(block)
+		if w := blockWidth(lines[i]); w > width {
+			width = w
+		}
+	}
+
+	var rows []string
+	for i := range blocks {
+		var offset int
+		switch alignment {
+		case AlignCenter:
+			offset = (width - blockWidth(lines[i])) / 2
+		case AlignRight:
+			offset = width - blockWidth(lines[i])
+		}
+		for _, line := range lines[i] {
+			if line == "" {
+				rows = append(rows, line)
+				continue
+			}
+			rows = append(rows, strings.Repeat(" ", offset)+line)
+		}
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// blockLines returns the lines of a block, without a trailing newline.
+func blockLines(block string) []string {
+	return strings.Split(strings.TrimSuffix(block, "\n"), "\n")
+}
+
+// blockWidth returns the display width of the widest line.
+func blockWidth(lines []string) int {
+	var width int
+	for _, line := range lines {
+		if w := internal.DisplayWidth(line); w > width {
+			width = w
+		}
+	}
+	return width
+}
@@ -0,0 +1,50 @@
+package putils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+func TestJoinHorizontal(t *testing.T) {
+	left := "a\nbbb\ncc\n"
+	right := "1\n2"
+	testza.AssertEqual(t, "a    1\nbbb  2\ncc   ", JoinHorizontal(left, right))
+	testza.AssertEqual(t, "1  a\n2  bbb\n   cc", JoinHorizontal(right, left))
+}
+
+func TestJoinHorizontalWithGutter(t *testing.T) {
+	testza.AssertEqual(t, "a | x | 1\nb | y | 2", JoinHorizontalWithGutter(" | ", "a\nb", "x\ny", "1\n2"))
+	testza.AssertEqual(t, "", JoinHorizontalWithGutter(" | "))
+	testza.AssertEqual(t, "single", JoinHorizontalWithGutter(" | ", "single"))
+}
+
+func TestJoinHorizontal_WideAndColoredBlocks(t *testing.T) {
+	left := "你好\n" + pterm.Red("ab")
+	joined := JoinHorizontalWithGutter("|", left, "x\ny")
+
+	lines := strings.Split(pterm.RemoveColorFromString(joined), "\n")
+	testza.AssertEqual(t, []string{"你好|x", "ab  |y"}, lines)
+}
+
+func TestJoinHorizontal_Boxes(t *testing.T) {
+	box1 := pterm.DefaultBox.Sprint("Hello")
+	box2 := pterm.DefaultBox.Sprint("Hello\nWorld")
+	lines := strings.Split(JoinHorizontal(box1, box2), "\n")
+
+	testza.AssertLen(t, lines, 4)
+	width := DisplayWidth(lines[0])
+	for _, line := range lines[:3] {
+		testza.AssertEqual(t, width, DisplayWidth(line))
+	}
+}
+
+func TestJoinVertical(t *testing.T) {
+	blocks := []string{"abcd", "ab\ncd", "a"}
+	testza.AssertEqual(t, "abcd\nab\ncd\na", JoinVertical(AlignLeft, blocks...))
+	testza.AssertEqual(t, "abcd\n ab\n cd\n a", JoinVertical(AlignCenter, blocks...))
+	testza.AssertEqual(t, "abcd\n  ab\n  cd\n   a", JoinVertical(AlignRight, blocks...))
+	testza.AssertEqual(t, "你好\n   a", JoinVertical(AlignRight, "你好", "a"))
+}
@@ -0,0 +1,31 @@
+package putils
+
+import (
+	"github.com/pterm/pterm"
+	"github.com/pterm/pterm/internal"
+)
+
+// JoinLetters concatenates the parts to a single Letters object and puts sep between them.
+//
+// Example:
+//
+//	letters := putils.JoinLetters(putils.LettersFromString(" "),
+//		putils.LettersFromStringWithStyle("P", pterm.FgCyan.ToStyle()),
+//		putils.LettersFromStringWithStyle("Term", pterm.FgLightMagenta.ToStyle()))
+func JoinLetters(sep pterm.Letters, parts ...pterm.Letters) pterm.Letters {
+	l := pterm.Letters{}
+	for i, part := range parts {
+		if i > 0 {
+			l = append(l, sep...)
+		}
+		l = append(l, part...)
+	}
+	return l
+}
+
+// LettersWidth returns the number of columns, which the letters take up when they are rendered by pterm.DefaultBigText.
+// It can be used to check if a big text fits into the terminal, before it's rendered.
+func LettersWidth(letters pterm.Letters) int {
+	s, _ := pterm.DefaultBigText.WithLetters(letters).Srender()
+	return internal.GetStringMaxWidth(pterm.RemoveColorFromString(s))
+}
@@ -0,0 +1,39 @@
+package putils
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+func TestJoinLetters(t *testing.T) {
+	letters := JoinLetters(LettersFromString("-"), LettersFromString("ab"), LettersFromString("c"), LettersFromString("de"))
+
+	var s string
+	for _, l := range letters {
+		s += l.String
+	}
+	testza.AssertEqual(t, "ab-c-de", s)
+}
+
+func TestJoinLettersKeepsStyles(t *testing.T) {
+	red := pterm.NewStyle(pterm.FgRed)
+	blue := pterm.NewStyle(pterm.FgBlue)
+	letters := JoinLetters(pterm.Letters{}, LettersFromStringWithStyle("a", red), LettersFromStringWithStyle("b", blue))
+
+	testza.AssertEqual(t, 2, len(letters))
+	testza.AssertEqual(t, red, letters[0].Style)
+	testza.AssertEqual(t, blue, letters[1].Style)
+}
+
+func TestJoinLettersWithoutParts(t *testing.T) {
+	testza.AssertEqual(t, 0, len(JoinLetters(LettersFromString(" "))))
+}
+
+func TestLettersWidth(t *testing.T) {
+	// "P" is 7 columns wide, "T" 8 columns, both with one column of spacing
+	testza.AssertEqual(t, 0, LettersWidth(pterm.Letters{}))
+	testza.AssertEqual(t, 8, LettersWidth(LettersFromString("P")))
+	testza.AssertEqual(t, 17, LettersWidth(LettersFromString("PT")))
+}
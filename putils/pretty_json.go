@@ -0,0 +1,100 @@
+package putils
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+// PrettyJSON returns the given JSON data indented and colorized.
+// Keys, strings, numbers, booleans and null values are styled with the styles of pterm.ThemeDefault.
+// If the data is not valid JSON, an error is returned.
+func PrettyJSON(data []byte) (string, error) {
+	// validate the whole input first, so that no partial output is produced
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var sb strings.Builder
+	if err := writePrettyJSONValue(&sb, decoder, 0); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+func writePrettyJSONValue(sb *strings.Builder, decoder *json.Decoder, level int) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		closing := "}"
+		if t == '[' {
+			closing = "]"
+		}
+		if !decoder.More() {
+			// consume the closing delimiter of the empty object or array
+			if _, err := decoder.Token(); err != nil {
+				return err
+			}
+			sb.WriteString(t.String() + closing)
+			return nil
+		}
+
+		sb.WriteString(t.String() + "\n")
+		first := true
+		for decoder.More() {
+			if !first {
+				sb.WriteString(",\n")
+			}
+			first = false
+			sb.WriteString(strings.Repeat("  ", level+1))
+			if t == '{' {
+				key, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				sb.WriteString(pterm.ThemeDefault.PrimaryStyle.Sprint(quoteJSON(key.(string))) + ": ")
+			}
+			if err := writePrettyJSONValue(sb, decoder, level+1); err != nil {
+				return err
+			}
+		}
+		if _, err := decoder.Token(); err != nil {
+			return err
+		}
+		sb.WriteString("\n" + strings.Repeat("  ", level) + closing)
+	case string:
+		sb.WriteString(pterm.ThemeDefault.SuccessMessageStyle.Sprint(quoteJSON(t)))
+	case json.Number:
+		sb.WriteString(pterm.ThemeDefault.SecondaryStyle.Sprint(t.String()))
+	case bool:
+		if t {
+			sb.WriteString(pterm.ThemeDefault.WarningMessageStyle.Sprint("true"))
+		} else {
+			sb.WriteString(pterm.ThemeDefault.WarningMessageStyle.Sprint("false"))
+		}
+	case nil:
+		sb.WriteString(pterm.ThemeDefault.DebugMessageStyle.Sprint("null"))
+	}
+
+	return nil
+}
+
+// quoteJSON returns s as a JSON string literal.
+func quoteJSON(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,93 @@
+package putils
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+func TestPrettyJSON(t *testing.T) {
+	pterm.DisableColor()
+	defer pterm.EnableColor()
+
+	s, err := PrettyJSON([]byte(`{"name":"pterm","stars":4.5,"tags":["cli","go"],"archived":false,"license":null,"meta":{},"list":[]}`))
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, `{
+  "name": "pterm",
+  "stars": 4.5,
+  "tags": [
+    "cli",
+    "go"
+  ],
+  "archived": false,
+  "license": null,
+  "meta": {},
+  "list": []
+}`, s)
+}
+
+func TestPrettyJSON_Colorized(t *testing.T) {
+	s, err := PrettyJSON([]byte(`{"key":1}`))
+	testza.AssertNoError(t, err)
+	testza.AssertContains(t, s, pterm.ThemeDefault.PrimaryStyle.Sprint(`"key"`))
+	testza.AssertContains(t, s, pterm.ThemeDefault.SecondaryStyle.Sprint("1"))
+}
+
+func TestPrettyJSON_Invalid(t *testing.T) {
+	s, err := PrettyJSON([]byte(`{"key": [1, 2}`))
+	testza.AssertNotNil(t, err)
+	testza.AssertEqual(t, "", s)
+}
+
+func TestPrettyYAML(t *testing.T) {
+	pterm.DisableColor()
+	defer pterm.EnableColor()
+
+	s, err := PrettyYAML([]byte(`name: pterm
+stars: 4.5
+tags: [cli, "go"]
+archived: false
+license:
+users:
+  - name: marvin
+    admin: true
+  - name: other
+empty: {}
+`))
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, `name: pterm
+stars: 4.5
+tags:
+  - cli
+  - "go"
+archived: false
+license: null
+users:
+  - name: marvin
+    admin: true
+  - name: other
+empty: {}`, s)
+}
+
+func TestPrettyYAML_MultipleDocuments(t *testing.T) {
+	pterm.DisableColor()
+	defer pterm.EnableColor()
+
+	s, err := PrettyYAML([]byte("a: 1\n---\nb: 2\n"))
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "a: 1\n---\nb: 2", s)
+}
+
+func TestPrettyYAML_Colorized(t *testing.T) {
+	s, err := PrettyYAML([]byte("key: true"))
+	testza.AssertNoError(t, err)
+	testza.AssertContains(t, s, pterm.ThemeDefault.PrimaryStyle.Sprint("key"))
+	testza.AssertContains(t, s, pterm.ThemeDefault.WarningMessageStyle.Sprint("true"))
+}
+
+func TestPrettyYAML_Invalid(t *testing.T) {
+	s, err := PrettyYAML([]byte("key: [1, 2"))
+	testza.AssertNotNil(t, err)
+	testza.AssertEqual(t, "", s)
+}
@@ -0,0 +1,151 @@
+package putils
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/pterm/pterm"
+)
+
+// PrettyYAML returns the given YAML data indented and colorized.
+// Keys, strings, numbers, booleans and null values are styled with the styles of pterm.ThemeDefault.
+// Multiple documents are separated by "---".
+// If the data is not valid YAML, an error is returned.
+func PrettyYAML(data []byte) (string, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+
+	var documents []string
+	for {
+		var node yaml.Node
+		err := decoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		var sb strings.Builder
+		writePrettyYAMLNode(&sb, &node, 0)
+		documents = append(documents, strings.TrimSuffix(sb.String(), "\n"))
+	}
+
+	return strings.Join(documents, "\n---\n"), nil
+}
+
+func writePrettyYAMLNode(sb *strings.Builder, node *yaml.Node, level int) {
+	indent := strings.Repeat("  ", level)
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, n := range node.Content {
+			writePrettyYAMLNode(sb, n, level)
+		}
+	case yaml.MappingNode:
+		if len(node.Content) == 0 {
+			sb.WriteString(indent + "{}\n")
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			sb.WriteString(indent + pterm.ThemeDefault.PrimaryStyle.Sprint(yamlScalarString(key)) + ":")
+			if isInlineYAMLNode(value) {
+				sb.WriteString(" " + prettyYAMLInline(value) + "\n")
+			} else {
+				sb.WriteString(yamlAnchor(value, " ") + "\n")
+				writePrettyYAMLNode(sb, value, level+1)
+			}
+		}
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			sb.WriteString(indent + "[]\n")
+			return
+		}
+		for _, item := range node.Content {
+			if isInlineYAMLNode(item) {
+				sb.WriteString(indent + "- " + prettyYAMLInline(item) + "\n")
+				continue
+			}
+			if item.Anchor != "" {
+				sb.WriteString(indent + "-" + yamlAnchor(item, " ") + "\n")
+				writePrettyYAMLNode(sb, item, level+1)
+				continue
+			}
+			// render the item one level deeper and replace the indentation of its first line with the dash
+			var itemBuilder strings.Builder
+			writePrettyYAMLNode(&itemBuilder, item, level+1)
+			sb.WriteString(indent + "- " + strings.TrimPrefix(itemBuilder.String(), indent+"  "))
+		}
+	default:
+		sb.WriteString(indent + prettyYAMLInline(node) + "\n")
+	}
+}
+
+// isInlineYAMLNode returns true if the node can be printed on the same line as its key or dash.
+func isInlineYAMLNode(node *yaml.Node) bool {
+	switch node.Kind {
+	case yaml.ScalarNode, yaml.AliasNode:
+		return true
+	case yaml.MappingNode, yaml.SequenceNode:
+		return len(node.Content) == 0
+	}
+	return false
+}
+
+func prettyYAMLInline(node *yaml.Node) string {
+	return yamlAnchor(node, "") + prettyYAMLInlineValue(node)
+}
+
+func prettyYAMLInlineValue(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return pterm.ThemeDefault.SecondaryStyle.Sprint("*" + node.Value)
+	case yaml.MappingNode:
+		return "{}"
+	case yaml.SequenceNode:
+		return "[]"
+	}
+
+	value := yamlScalarString(node)
+	switch node.ShortTag() {
+	case "!!int", "!!float":
+		return pterm.ThemeDefault.SecondaryStyle.Sprint(value)
+	case "!!bool":
+		return pterm.ThemeDefault.WarningMessageStyle.Sprint(value)
+	case "!!null":
+		return pterm.ThemeDefault.DebugMessageStyle.Sprint(value)
+	default:
+		return pterm.ThemeDefault.SuccessMessageStyle.Sprint(value)
+	}
+}
+
+// yamlScalarString returns the scalar value with the quoting of the original input.
+// Block scalars are converted to double-quoted strings, to keep them on one line.
+func yamlScalarString(node *yaml.Node) string {
+	switch {
+	case node.Style&yaml.SingleQuotedStyle != 0:
+		return "'" + strings.ReplaceAll(node.Value, "'", "''") + "'"
+	case node.Style&(yaml.DoubleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) != 0:
+		return strconv.Quote(node.Value)
+	case node.Value == "" && node.ShortTag() == "!!null":
+		return "null"
+	}
+	return node.Value
+}
+
+// yamlAnchor returns the styled anchor of the node, or an empty string if the node has no anchor.
+func yamlAnchor(node *yaml.Node, prefix string) string {
+	if node.Anchor == "" {
+		return ""
+	}
+	anchor := prefix + pterm.ThemeDefault.SecondaryStyle.Sprint("&"+node.Anchor)
+	if node.Kind == yaml.ScalarNode || node.Kind == yaml.AliasNode || len(node.Content) == 0 {
+		anchor += " "
+	}
+	return anchor
+}
@@ -0,0 +1,58 @@
+package putils
+
+import (
+	"errors"
+	"io"
+
+	"github.com/pterm/pterm"
+)
+
+// progressReader advances a progressbar for every chunk read from r.
+type progressReader struct {
+	r       io.Reader
+	counter progressbarWriter
+	done    bool
+}
+
+// NewProgressReader returns an io.Reader, which reads from r and adds the number of read bytes to the progressbar.
+// The progressbar is started with the given total, if it's not already active, and stopped when r returns io.EOF.
+// If total is negative, the size is unknown and the progressbar is started in indeterminate mode,
+// or keeps its total, if it's already active.
+//
+// Example:
+//
+//	resp, _ := http.Get(url)
+//	reader := putils.NewProgressReader(resp.Body, int(resp.ContentLength), pterm.DefaultProgressbar.WithTitle("Downloading"))
+//	io.Copy(file, reader)
+func NewProgressReader(r io.Reader, total int, bar *pterm.ProgressbarPrinter) io.Reader {
+	if bar == nil {
+		bar = &pterm.DefaultProgressbar
+	}
+	pr := &progressReader{r: r}
+
+	switch {
+	case bar.IsActive:
+		if total >= 0 {
+			bar.SetTotal(total)
+		}
+		pr.counter.pb = bar
+	case total < 0:
+		pr.counter.pb, _ = bar.WithIndeterminate().Start()
+	default:
+		pr.counter.pb, _ = bar.WithTotal(total).Start()
+	}
+
+	return pr
+}
+
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.r.Read(p)
+	if n > 0 && !pr.done {
+		_, _ = pr.counter.Write(p[:n])
+	}
+	if errors.Is(err, io.EOF) && !pr.done {
+		pr.done = true
+		_, _ = pr.counter.pb.Stop()
+	}
+	return n, err
+}
@@ -0,0 +1,64 @@
+package putils
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+func TestNewProgressReader(t *testing.T) {
+	var out bytes.Buffer
+	bar := pterm.DefaultProgressbar.WithWriter(&out)
+	reader := NewProgressReader(strings.NewReader("Hello, World!"), 13, bar)
+
+	data, err := io.ReadAll(reader)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Hello, World!", string(data))
+
+	pr := reader.(*progressReader)
+	testza.AssertEqual(t, 13, pr.counter.pb.Current)
+	testza.AssertFalse(t, pr.counter.pb.IsActive)
+}
+
+func TestNewProgressReader_ActiveBar(t *testing.T) {
+	var out bytes.Buffer
+	bar, _ := pterm.DefaultProgressbar.WithWriter(&out).Start()
+	reader := NewProgressReader(strings.NewReader("Hello"), 5, bar)
+
+	_, err := io.ReadAll(reader)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 5, bar.Current)
+	testza.AssertEqual(t, 5, bar.Total)
+	testza.AssertFalse(t, bar.IsActive)
+}
+
+func TestNewProgressReader_UnknownTotal(t *testing.T) {
+	var out bytes.Buffer
+	bar := pterm.DefaultProgressbar.WithTitle("Reading").WithWriter(&out)
+	reader := NewProgressReader(strings.NewReader("Hello, World!"), -1, bar)
+
+	data, err := io.ReadAll(reader)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Hello, World!", string(data))
+
+	pr := reader.(*progressReader)
+	testza.AssertTrue(t, pr.counter.pb.Indeterminate)
+	testza.AssertEqual(t, 13, pr.counter.pb.Current)
+	testza.AssertFalse(t, pr.counter.pb.IsActive)
+}
+
+func TestNewProgressReader_ActiveBarUnknownTotal(t *testing.T) {
+	var out bytes.Buffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithWriter(&out).Start()
+	reader := NewProgressReader(strings.NewReader("Hello"), -1, bar)
+
+	_, err := io.ReadAll(reader)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 5, bar.Current)
+	testza.AssertEqual(t, 10, bar.Total)
+	testza.AssertFalse(t, bar.IsActive)
+}
@@ -0,0 +1,88 @@
+package putils
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+// sparklineLevels are the characters of a sparkline, from the lowest to the highest value.
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// SparklineOption configures the output of Sparkline.
+type SparklineOption func(*sparklineConfig)
+
+type sparklineConfig struct {
+	gradientStart pterm.RGB
+	gradientEnd   []pterm.RGB
+	colored       bool
+}
+
+// SparklineGradient colors every character of the sparkline by its value.
+// The lowest value gets the start color, the highest value gets the last end color.
+// The colors in between are calculated with pterm.RGB.Fade.
+func SparklineGradient(start pterm.RGB, end ...pterm.RGB) SparklineOption {
+	return func(c *sparklineConfig) {
+		c.gradientStart = start
+		c.gradientEnd = end
+		c.colored = true
+	}
+}
+
+// Sparkline returns a single line, which shows the values as Unicode block characters.
+// The values are scaled between the minimum and the maximum of the series.
+// A constant series is shown as a flat line in the middle, and NaN values are shown as spaces.
+// Infinite values are not used for the scale and are shown as the lowest or the highest character.
+//
+// Usage:
+//
+//	pterm.Println(putils.Sparkline([]float64{1, 5, 2, 8}, putils.SparklineGradient(pterm.NewRGB(0, 255, 0), pterm.NewRGB(255, 0, 0))))
+func Sparkline(values []float64, opts ...SparklineOption) string {
+	var config sparklineConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		if math.IsNaN(v) {
+			sb.WriteString(" ")
+			continue
+		}
+
+		fraction := sparklineFraction(v, min, max)
+		level := string(sparklineLevels[int(math.Round(fraction*float64(len(sparklineLevels)-1)))])
+
+		if config.colored {
+			level = config.gradientStart.Fade(0, 1, float32(fraction), config.gradientEnd...).Sprint(level)
+		}
+		sb.WriteString(level)
+	}
+
+	return sb.String()
+}
+
+// sparklineFraction returns the position of v between min and max, from 0 to 1.
+// The values are halved, so that the range of very large values doesn't overflow.
+func sparklineFraction(v, min, max float64) float64 {
+	switch {
+	case math.IsInf(v, 1):
+		return 1
+	case math.IsInf(v, -1):
+		return 0
+	case !(max > min):
+		return 0.5
+	}
+	fraction := (v/2 - min/2) / (max/2 - min/2)
+	return math.Max(0, math.Min(1, fraction))
+}
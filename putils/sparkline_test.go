@@ -0,0 +1,49 @@
+package putils
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestSparkline(t *testing.T) {
+	testza.AssertEqual(t, "▁▂▃▄▅▆▇█", Sparkline([]float64{0, 1, 2, 3, 4, 5, 6, 7}))
+	testza.AssertEqual(t, "█▁▅", Sparkline([]float64{10, -10, 2}))
+}
+
+func TestSparkline_Empty(t *testing.T) {
+	testza.AssertEqual(t, "", Sparkline(nil))
+	testza.AssertEqual(t, "", Sparkline([]float64{}))
+}
+
+func TestSparkline_Constant(t *testing.T) {
+	testza.AssertEqual(t, "▅▅▅", Sparkline([]float64{3, 3, 3}))
+	testza.AssertEqual(t, "▅", Sparkline([]float64{42}))
+}
+
+func TestSparkline_NaN(t *testing.T) {
+	testza.AssertEqual(t, "▁ █", Sparkline([]float64{1, math.NaN(), 2}))
+	testza.AssertEqual(t, " ", Sparkline([]float64{math.NaN()}))
+}
+
+func TestSparkline_Infinite(t *testing.T) {
+	testza.AssertEqual(t, "▁█▅", Sparkline([]float64{math.Inf(-1), math.Inf(1), 1}))
+	testza.AssertEqual(t, "▁█ ", Sparkline([]float64{math.Inf(-1), math.Inf(1), math.NaN()}))
+	testza.AssertEqual(t, "▁██", Sparkline([]float64{1, math.Inf(1), 2}))
+}
+
+func TestSparkline_LargeRange(t *testing.T) {
+	testza.AssertEqual(t, "▁▅█", Sparkline([]float64{-1e308, 0, 1e308}))
+	testza.AssertEqual(t, "▁█", Sparkline([]float64{-math.MaxFloat64, math.MaxFloat64}))
+}
+
+func TestSparkline_Gradient(t *testing.T) {
+	s := Sparkline([]float64{0, 1}, SparklineGradient(pterm.NewRGB(0, 255, 0), pterm.NewRGB(255, 0, 0)))
+
+	testza.AssertEqual(t, "▁█", pterm.RemoveColorFromString(s))
+	testza.AssertContains(t, s, pterm.NewRGB(0, 255, 0).Sprint("▁"))
+	testza.AssertContains(t, s, pterm.NewRGB(255, 0, 0).Sprint("█"))
+}
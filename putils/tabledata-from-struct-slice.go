@@ -1,6 +1,7 @@
 package putils
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/pterm/pterm"
@@ -10,62 +11,125 @@ import (
 // The table will be populated with the values of the structs. The header will be set to the structs field name.
 // Use .WithHasHeader() to color the header.
 // The function will return the populated pterm.TablePrinter.
+// The columns are built like in TableFromStructs. If the value is not a slice of structs, the table printer is returned unchanged.
 func TableFromStructSlice(tablePrinter pterm.TablePrinter, structSlice interface{}) *pterm.TablePrinter {
-	to := reflect.TypeOf(structSlice)
-	if to.Kind() != reflect.Slice {
+	data, err := TableFromStructs(structSlice)
+	if err != nil {
 		return &tablePrinter
 	}
-	el := to.Elem()
+	tablePrinter.Data = data
 
-	isPointer := false
-	if el.Kind() == reflect.Ptr {
-		el = el.Elem()
-		isPointer = true
+	return &tablePrinter
+}
+
+// DefaultTableFromStructSlice will be populate the pterm.DefaultTable with the values of the structs. The header will be set to the structs field name.
+// Use .WithHasHeader() to color the header.
+// The function will return the populated pterm.TablePrinter.
+func DefaultTableFromStructSlice(structSlice interface{}) *pterm.TablePrinter {
+	return TableFromStructSlice(pterm.DefaultTable, structSlice)
+}
+
+// TableFromStructsOption configures the output of TableFromStructs.
+type TableFromStructsOption func(*tableFromStructsConfig)
+
+type tableFromStructsConfig struct {
+	columns []string
+}
+
+// TableColumns selects the fields, which are shown in the table, by their field names.
+// The columns are shown in the given order.
+func TableColumns(fieldNames ...string) TableFromStructsOption {
+	return func(c *tableFromStructsConfig) {
+		c.columns = fieldNames
 	}
+}
 
-	if el.Kind() != reflect.Struct {
-		return &tablePrinter
+// TableFromStructs converts a slice of structs into pterm.TableData.
+// The first row is the header, which contains the names of the exported fields.
+// A header can be renamed with a `pterm:"Name"` struct tag, and a field can be skipped with `pterm:"-"`.
+// Every element of the slice becomes a row, and every field is formatted with fmt.Sprint.
+// An empty slice returns a table, which only contains the header.
+// If the value is not a slice of structs, pterm.ErrNotAStructSlice is returned.
+// Selecting an unknown or skipped field with TableColumns returns an error, too.
+//
+// Usage:
+//
+//	data, _ := putils.TableFromStructs(users, putils.TableColumns("Name", "Email"))
+//	pterm.DefaultTable.WithHasHeader().WithData(data).Render()
+func TableFromStructs(slice interface{}, opts ...TableFromStructsOption) (pterm.TableData, error) {
+	var config tableFromStructsConfig
+	for _, opt := range opts {
+		opt(&config)
 	}
 
-	numFields := el.NumField()
-	fieldNames := make([]string, numFields)
+	value := reflect.ValueOf(slice)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, pterm.ErrNotAStructSlice
+	}
+	elem := value.Type().Elem()
+	isPointer := elem.Kind() == reflect.Ptr
+	if isPointer {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, pterm.ErrNotAStructSlice
+	}
 
-	for i := 0; i < numFields; i++ {
-		fieldNames[i] = el.Field(i).Name
+	fields, header, err := structColumns(elem, config.columns)
+	if err != nil {
+		return nil, err
 	}
 
-	records := pterm.TableData{
-		fieldNames,
+	data := pterm.TableData{header}
+	for i := 0; i < value.Len(); i++ {
+		item := value.Index(i)
+		row := make([]string, len(fields))
+		if isPointer {
+			if item.IsNil() {
+				data = append(data, row)
+				continue
+			}
+			item = item.Elem()
+		}
+		for j, field := range fields {
+			row[j] = fmt.Sprint(item.Field(field).Interface())
+		}
+		data = append(data, row)
 	}
 
-	obj := reflect.ValueOf(structSlice)
+	return data, nil
+}
 
-	items := make([]interface{}, obj.Len())
-	for i := 0; i < obj.Len(); i++ {
-		if isPointer {
-			items[i] = obj.Index(i).Elem().Interface()
-		} else {
-			items[i] = obj.Index(i).Interface()
+// structColumns returns the field indexes and the header names of the columns of a struct type.
+func structColumns(t reflect.Type, columns []string) (fields []int, header []string, err error) {
+	names := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("pterm")
+		if field.PkgPath != "" || tag == "-" {
+			continue
+		}
+		names[field.Name] = i
+		if len(columns) == 0 {
+			fields = append(fields, i)
 		}
 	}
 
-	for _, v := range items {
-		item := reflect.ValueOf(v)
-		record := make([]string, numFields)
-		for i := 0; i < numFields; i++ {
-			fieldVal := item.Field(i).Interface()
-			record[i] = pterm.Sprintf("%v", fieldVal)
+	for _, column := range columns {
+		i, ok := names[column]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown field %q", column)
 		}
-		records = append(records, record)
+		fields = append(fields, i)
 	}
-	tablePrinter.Data = records
 
-	return &tablePrinter
-}
+	header = make([]string, len(fields))
+	for i, field := range fields {
+		header[i] = t.Field(field).Name
+		if tag := t.Field(field).Tag.Get("pterm"); tag != "" {
+			header[i] = tag
+		}
+	}
 
-// DefaultTableFromStructSlice will be populate the pterm.DefaultTable with the values of the structs. The header will be set to the structs field name.
-// Use .WithHasHeader() to color the header.
-// The function will return the populated pterm.TablePrinter.
-func DefaultTableFromStructSlice(structSlice interface{}) *pterm.TablePrinter {
-	return TableFromStructSlice(pterm.DefaultTable, structSlice)
+	return fields, header, nil
 }
@@ -0,0 +1,81 @@
+package putils
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+type tableUser struct {
+	Name    string
+	Email   string `pterm:"E-Mail"`
+	Age     int
+	Secret  string `pterm:"-"`
+	private string
+}
+
+func TestTableFromStructs(t *testing.T) {
+	users := []tableUser{
+		{Name: "Marvin", Email: "marvin@example.com", Age: 24, Secret: "x", private: "y"},
+		{Name: "Jane", Email: "jane@example.com", Age: 31},
+	}
+
+	data, err := TableFromStructs(users)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, pterm.TableData{
+		{"Name", "E-Mail", "Age"},
+		{"Marvin", "marvin@example.com", "24"},
+		{"Jane", "jane@example.com", "31"},
+	}, data)
+}
+
+func TestTableFromStructs_Pointers(t *testing.T) {
+	users := []*tableUser{{Name: "Marvin", Age: 24}, nil}
+
+	data, err := TableFromStructs(users)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, pterm.TableData{
+		{"Name", "E-Mail", "Age"},
+		{"Marvin", "", "24"},
+		{"", "", ""},
+	}, data)
+}
+
+func TestTableFromStructs_Empty(t *testing.T) {
+	var users []tableUser
+
+	data, err := TableFromStructs(users)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, pterm.TableData{{"Name", "E-Mail", "Age"}}, data)
+}
+
+func TestTableFromStructs_Columns(t *testing.T) {
+	users := []tableUser{{Name: "Marvin", Email: "marvin@example.com", Age: 24}}
+
+	data, err := TableFromStructs(users, TableColumns("Age", "Name"))
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, pterm.TableData{{"Age", "Name"}, {"24", "Marvin"}}, data)
+
+	_, err = TableFromStructs(users, TableColumns("Secret"))
+	testza.AssertNotNil(t, err)
+}
+
+func TestTableFromStructs_InvalidInput(t *testing.T) {
+	for _, input := range []interface{}{nil, "text", tableUser{}, []string{"a"}} {
+		_, err := TableFromStructs(input)
+		testza.AssertErrorIs(t, err, pterm.ErrNotAStructSlice)
+	}
+}
+
+func TestTableFromStructSlice(t *testing.T) {
+	users := []*tableUser{{Name: "Marvin", Email: "marvin@example.com", Age: 24, private: "y"}}
+
+	table := DefaultTableFromStructSlice(users)
+	testza.AssertEqual(t, pterm.TableData{
+		{"Name", "E-Mail", "Age"},
+		{"Marvin", "marvin@example.com", "24"},
+	}, table.Data)
+
+	testza.AssertEqual(t, pterm.DefaultTable.Data, DefaultTableFromStructSlice([]string{"a"}).Data)
+}
@@ -0,0 +1,97 @@
+package putils
+
+import (
+	"strings"
+
+	"github.com/pterm/pterm/internal"
+)
+
+// WrapOptions configures how WrapText indents the wrapped lines.
+type WrapOptions struct {
+	// Indent is the number of spaces in front of the first line of every paragraph.
+	Indent int
+	// HangingIndent is the number of spaces in front of every other line of a paragraph.
+	HangingIndent int
+}
+
+// WrapText wraps the text at spaces, so that no line is wider than width, including the indentation.
+// Paragraphs are separated by blank lines, which are kept. Inside of a paragraph, line breaks and consecutive whitespace are collapsed.
+// Words, which are wider than a line, are split. Color codes are never split and do not count towards the width.
+//
+// Usage:
+//
+//	putils.WrapText("--verbose  Print more information about what is going on.", 30, putils.WrapOptions{HangingIndent: 11})
+func WrapText(text string, width int, opts WrapOptions) string {
+	var lines []string
+	var paragraph []string
+	flushParagraph := func() {
+		if len(paragraph) > 0 {
+			lines = append(lines, wrapParagraph(strings.Join(paragraph, " "), width, opts)...)
+			paragraph = nil
+		}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			flushParagraph()
+			lines = append(lines, "")
+			continue
+		}
+		paragraph = append(paragraph, line)
+	}
+	flushParagraph()
+
+	return strings.Join(lines, "\n")
+}
+
+// wrapParagraph wraps a single paragraph into lines, which are indented as configured in opts.
+func wrapParagraph(paragraph string, width int, opts WrapOptions) []string {
+	var lines []string
+	indent := opts.Indent
+	var line string
+	var lineWidth int
+
+	available := func() int {
+		if width-indent < 1 {
+			return 1
+		}
+		return width - indent
+	}
+	flushLine := func() {
+		if indent > 0 {
+			line = strings.Repeat(" ", indent) + line
+		}
+		lines = append(lines, line)
+		indent, line, lineWidth = opts.HangingIndent, "", 0
+	}
+
+	for _, word := range strings.Fields(paragraph) {
+		wordWidth := internal.DisplayWidth(word)
+		if line != "" && lineWidth+1+wordWidth <= available() {
+			line += " " + word
+			lineWidth += 1 + wordWidth
+			continue
+		}
+		if line != "" {
+			flushLine()
+		}
+
+		// words, which are wider than a whole line, are split into multiple lines
+		for wordWidth > available() {
+			parts := internal.SplitStringByWidth(word, available())
+			if len(parts) < 2 {
+				break
+			}
+			line = parts[0]
+			flushLine()
+			word = strings.Join(parts[1:], "")
+			wordWidth = internal.DisplayWidth(word)
+		}
+		line, lineWidth = word, wordWidth
+	}
+	if line != "" {
+		flushLine()
+	}
+
+	return lines
+}
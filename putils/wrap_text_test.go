@@ -0,0 +1,37 @@
+package putils
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+)
+
+func TestWrapText(t *testing.T) {
+	testza.AssertEqual(t, "Hello\nWorld", WrapText("Hello World", 10, WrapOptions{}))
+	testza.AssertEqual(t, "Hello World", WrapText("Hello\nWorld", 11, WrapOptions{}))
+}
+
+func TestWrapText_Indents(t *testing.T) {
+	text := "--verbose Print more information about the process."
+	expected := "  --verbose Print\n    more\n    information\n    about the\n    process."
+	testza.AssertEqual(t, expected, WrapText(text, 17, WrapOptions{Indent: 2, HangingIndent: 4}))
+}
+
+func TestWrapText_KeepsParagraphs(t *testing.T) {
+	text := "First paragraph here.\n\nSecond one."
+	expected := "  First\nparagraph\nhere.\n\n  Second\none."
+	testza.AssertEqual(t, expected, WrapText(text, 10, WrapOptions{Indent: 2}))
+}
+
+func TestWrapText_BreaksLongWords(t *testing.T) {
+	testza.AssertEqual(t, "a\n  abcd\n  efgh\n  ij", WrapText("a abcdefghij", 6, WrapOptions{HangingIndent: 2}))
+	testza.AssertEqual(t, "  abc\n  def", WrapText("abcdef", 5, WrapOptions{Indent: 2, HangingIndent: 2}))
+	// the width is never below one column
+	testza.AssertEqual(t, "  a\n  b", WrapText("ab", 1, WrapOptions{Indent: 2, HangingIndent: 2}))
+}
+
+func TestWrapText_KeepsColorCodes(t *testing.T) {
+	text := "\x1b[31mHello\x1b[0m World"
+	testza.AssertEqual(t, "\x1b[31mHello\x1b[0m\nWorld", WrapText(text, 6, WrapOptions{}))
+	testza.AssertEqual(t, "\x1b[31mHel\nlo\x1b[0m\nWor\nld", WrapText(text, 3, WrapOptions{}))
+}
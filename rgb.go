@@ -2,6 +2,8 @@ package pterm
 
 import (
 	"fmt"
+	"math"
+	"strings"
 
 	"github.com/gookit/color"
 
@@ -57,10 +59,188 @@ func (p RGB) Fade(min, max, current float32, end ...RGB) RGB {
 	return p
 }
 
+// Mix blends the color linearly with another color.
+// A t of 0 returns the color itself, and a t of 1 returns the other color. t is clamped to [0, 1].
+func (p RGB) Mix(other RGB, t float32) RGB {
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+	return RGB{
+		R: mixChannel(p.R, other.R, t),
+		G: mixChannel(p.G, other.G, t),
+		B: mixChannel(p.B, other.B, t),
+	}
+}
+
+// Lighten mixes the color with white. An amount of 1 returns white.
+func (p RGB) Lighten(amount float32) RGB {
+	return p.Mix(NewRGB(255, 255, 255), amount)
+}
+
+// Darken mixes the color with black. An amount of 1 returns black.
+func (p RGB) Darken(amount float32) RGB {
+	return p.Mix(NewRGB(0, 0, 0), amount)
+}
+
+// mixChannel blends two color channels and rounds the result.
+func mixChannel(a, b uint8, t float32) uint8 {
+	return uint8(math.Round(float64(float32(a) + (float32(b)-float32(a))*t)))
+}
+
+// xterm256CubeLevels are the channel values of the 6x6x6 color cube of the xterm 256 color palette.
+var xterm256CubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// xterm16Palette are the RGB values of the 16 basic colors in the default xterm palette.
+var xterm16Palette = [16]RGB{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0}, {0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0}, {92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// To256 returns the index of the nearest color in the xterm 256 color palette.
+// Only the color cube (16 - 231) and the grayscale ramp (232 - 255) are matched, because the basic colors differ between terminals.
+func (p RGB) To256() uint8 {
+	cubeIndex := func(v uint8) int {
+		if v < 48 {
+			return 0
+		}
+		if v < 115 {
+			return 1
+		}
+		return (int(v) - 35) / 40
+	}
+	r, g, b := cubeIndex(p.R), cubeIndex(p.G), cubeIndex(p.B)
+	cube := RGB{R: xterm256CubeLevels[r], G: xterm256CubeLevels[g], B: xterm256CubeLevels[b]}
+	cubeColor := uint8(16 + 36*r + 6*g + b)
+	if cube == p {
+		return cubeColor
+	}
+
+	grayIndex := 23
+	if average := (int(p.R) + int(p.G) + int(p.B)) / 3; average <= 238 {
+		grayIndex = (average - 3) / 10
+	}
+	gray := uint8(8 + 10*grayIndex)
+	if p.distance(RGB{R: gray, G: gray, B: gray}) < p.distance(cube) {
+		return uint8(232 + grayIndex)
+	}
+	return cubeColor
+}
+
+// To16 returns the nearest of the 16 basic foreground colors, as shown by the default xterm palette.
+func (p RGB) To16() Color {
+	nearest := 0
+	for i, c := range xterm16Palette {
+		if p.distance(c) < p.distance(xterm16Palette[nearest]) {
+			nearest = i
+		}
+	}
+	if nearest < 8 {
+		return FgBlack + Color(nearest)
+	}
+	return FgDarkGray + Color(nearest-8)
+}
+
+// distance returns the squared euclidean distance between two colors.
+func (p RGB) distance(other RGB) int {
+	dr, dg, db := int(p.R)-int(other.R), int(p.G)-int(other.G), int(p.B)-int(other.B)
+	return dr*dr + dg*dg + db*db
+}
+
+// ToStyle converts the RGB to a Style, which prints the text in the RGB color.
+// The Style can be combined with other colors and is accepted by every printer, which takes a Style.
+// If DownsampleRGB is enabled, the color is converted to the nearest color the terminal supports, like in Sprint.
+func (p RGB) ToStyle() *Style {
+	return p.toStyle(false)
+}
+
+// ToBackgroundStyle converts the RGB to a Style, which prints the background in the RGB color.
+// If DownsampleRGB is enabled, the color is converted to the nearest color the terminal supports, like in Sprint.
+func (p RGB) ToBackgroundStyle() *Style {
+	return p.toStyle(true)
+}
+
+// toStyle returns the Style of the foreground or background color.
+func (p RGB) toStyle(background bool) *Style {
+	var offset Color
+	if background {
+		offset = BgBlack - FgBlack
+	}
+	if DownsampleRGB.Load() {
+		switch ColorProfile() {
+		case ANSI256:
+			return &Style{38 + offset, 5, Color(p.To256())}
+		case ANSI16:
+			return &Style{p.To16() + offset}
+		case NoColor:
+			return &Style{}
+		}
+	}
+	return &Style{38 + offset, 2, Color(p.R), Color(p.G), Color(p.B)}
+}
+
+// ContrastRatio returns the contrast ratio between two colors, as defined by the WCAG.
+// The ratio ranges from 1 (no contrast) to 21 (black on white).
+func (p RGB) ContrastRatio(other RGB) float64 {
+	l1, l2 := p.relativeLuminance(), other.relativeLuminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// ReadableForeground returns black or white, whichever has the higher contrast on the color.
+func (p RGB) ReadableForeground() RGB {
+	black, white := NewRGB(0, 0, 0), NewRGB(255, 255, 255)
+	if p.ContrastRatio(black) >= p.ContrastRatio(white) {
+		return black
+	}
+	return white
+}
+
+// relativeLuminance returns the relative luminance of the color, as defined by the WCAG.
+func (p RGB) relativeLuminance() float64 {
+	channel := func(c uint8) float64 {
+		v := float64(c) / 255
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(p.R) + 0.7152*channel(p.G) + 0.0722*channel(p.B)
+}
+
+// sprintOnBackground prints the text on the color as background, using the ReadableForeground as text color.
+func (p RGB) sprintOnBackground(a ...interface{}) string {
+	code := color.NewRGBStyle(color.RGB(p.ReadableForeground().GetValues()), color.RGB(p.R, p.G, p.B)).String()
+	message := color.Sprint(a...)
+	messageLines := strings.Split(message, "\n")
+	for i, line := range messageLines {
+		// Restore the background after inner styles reset the colors.
+		messageLines[i] = color.RenderCode(code, strings.ReplaceAll(line, color.ResetSet, "\x1b[0m\x1b["+code+"m"))
+	}
+	return strings.Join(messageLines, "\n")
+}
+
 // Sprint formats using the default formats for its operands and returns the resulting string.
 // Spaces are added between operands when neither is a string.
+// If DownsampleRGB is enabled, the color is converted to the nearest color the terminal supports.
 func (p RGB) Sprint(a ...interface{}) string {
-	return color.RGB(p.R, p.G, p.B).Sprint(a...)
+	c := color.RGB(p.R, p.G, p.B)
+	if !DownsampleRGB.Load() {
+		return c.Sprint(a...)
+	}
+
+	switch ColorProfile() {
+	case ANSI256:
+		return color.C256(p.To256()).Sprint(a...)
+	case ANSI16:
+		return p.To16().Sprint(a...)
+	case NoColor:
+		return Sprint(a...)
+	}
+	return c.Sprint(a...)
 }
 
 // Sprintln formats using the default formats for its operands and returns the resulting string.
@@ -1,7 +1,9 @@
 package pterm_test
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"reflect"
 	"strconv"
@@ -305,3 +307,129 @@ func TestRGB_PrintIfError_WithoutErrorf(t *testing.T) {
 		})
 	}
 }
+
+func TestRGB_ContrastRatio(t *testing.T) {
+	black := pterm.NewRGB(0, 0, 0)
+	white := pterm.NewRGB(255, 255, 255)
+
+	testza.AssertEqual(t, 21.0, black.ContrastRatio(white))
+	testza.AssertEqual(t, 21.0, white.ContrastRatio(black))
+	testza.AssertEqual(t, 1.0, white.ContrastRatio(white))
+	testza.AssertEqual(t, "4.00", fmt.Sprintf("%.2f", pterm.NewRGB(255, 0, 0).ContrastRatio(white)))
+}
+
+func TestRGB_ReadableForeground(t *testing.T) {
+	black := pterm.NewRGB(0, 0, 0)
+	white := pterm.NewRGB(255, 255, 255)
+
+	testza.AssertEqual(t, black, white.ReadableForeground())
+	testza.AssertEqual(t, white, black.ReadableForeground())
+	testza.AssertEqual(t, black, pterm.NewRGB(255, 255, 0).ReadableForeground())
+	testza.AssertEqual(t, white, pterm.NewRGB(0, 0, 128).ReadableForeground())
+}
+
+func TestRGB_ToStyle(t *testing.T) {
+	s := pterm.NewRGB(10, 20, 30).ToStyle()
+	testza.AssertEqual(t, "38;2;10;20;30", s.String())
+
+	out := s.Add(pterm.Style{pterm.Bold}).Sprint("Hello")
+	testza.AssertContains(t, out, "\x1b[38;2;10;20;30;1m")
+	testza.AssertEqual(t, "Hello", pterm.RemoveColorFromString(out))
+}
+
+func TestRGB_ToBackgroundStyle(t *testing.T) {
+	testza.AssertEqual(t, "48;2;10;20;30", pterm.NewRGB(10, 20, 30).ToBackgroundStyle().String())
+}
+
+func TestRGB_ToStyleInPrinter(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(2).WithBarStyle(pterm.NewRGB(255, 0, 0).ToStyle()).WithWriter(&buf).Start()
+	p.Add(1)
+	p.Stop()
+
+	testza.AssertContains(t, buf.String(), "\x1b[38;2;255;0;0m")
+}
+
+func TestRGB_Mix(t *testing.T) {
+	red := pterm.NewRGB(255, 0, 0)
+	blue := pterm.NewRGB(0, 0, 255)
+
+	testza.AssertEqual(t, red, red.Mix(blue, 0))
+	testza.AssertEqual(t, blue, red.Mix(blue, 1))
+	testza.AssertEqual(t, pterm.NewRGB(128, 0, 128), red.Mix(blue, 0.5))
+	testza.AssertEqual(t, pterm.NewRGB(191, 0, 64), red.Mix(blue, 0.25))
+}
+
+func TestRGB_MixClampsT(t *testing.T) {
+	red := pterm.NewRGB(255, 0, 0)
+	blue := pterm.NewRGB(0, 0, 255)
+
+	testza.AssertEqual(t, red, red.Mix(blue, -1))
+	testza.AssertEqual(t, blue, red.Mix(blue, 2))
+}
+
+func TestRGB_Lighten(t *testing.T) {
+	c := pterm.NewRGB(100, 50, 0)
+
+	testza.AssertEqual(t, c, c.Lighten(0))
+	testza.AssertEqual(t, pterm.NewRGB(178, 153, 128), c.Lighten(0.5))
+	testza.AssertEqual(t, pterm.NewRGB(255, 255, 255), c.Lighten(1))
+	testza.AssertEqual(t, pterm.NewRGB(255, 255, 255), c.Lighten(5))
+}
+
+func TestRGB_Darken(t *testing.T) {
+	c := pterm.NewRGB(100, 50, 200)
+
+	testza.AssertEqual(t, c, c.Darken(0))
+	testza.AssertEqual(t, pterm.NewRGB(50, 25, 100), c.Darken(0.5))
+	testza.AssertEqual(t, pterm.NewRGB(0, 0, 0), c.Darken(1))
+	testza.AssertEqual(t, c, c.Darken(-1))
+}
+
+func TestRGB_MixComposesWithToStyle(t *testing.T) {
+	base := pterm.NewRGB(0, 100, 200)
+	hover := base.Lighten(0.2)
+
+	testza.AssertEqual(t, pterm.NewRGB(51, 131, 211), hover)
+	testza.AssertEqual(t, "38;2;51;131;211", hover.ToStyle().String())
+}
+
+func TestRGB_To256(t *testing.T) {
+	tests := []struct {
+		rgb      pterm.RGB
+		expected uint8
+	}{
+		{pterm.NewRGB(0, 0, 0), 16},
+		{pterm.NewRGB(255, 255, 255), 231},
+		{pterm.NewRGB(255, 0, 0), 196},
+		{pterm.NewRGB(0, 255, 0), 46},
+		{pterm.NewRGB(0, 0, 255), 21},
+		{pterm.NewRGB(95, 135, 175), 67},
+		{pterm.NewRGB(10, 200, 30), 40},
+		{pterm.NewRGB(8, 8, 8), 232},
+		{pterm.NewRGB(128, 128, 128), 244},
+		{pterm.NewRGB(238, 238, 238), 255},
+	}
+	for _, tt := range tests {
+		testza.AssertEqual(t, tt.expected, tt.rgb.To256(), tt.rgb)
+	}
+}
+
+func TestRGB_To16(t *testing.T) {
+	tests := []struct {
+		rgb      pterm.RGB
+		expected pterm.Color
+	}{
+		{pterm.NewRGB(0, 0, 0), pterm.FgBlack},
+		{pterm.NewRGB(200, 10, 10), pterm.FgRed},
+		{pterm.NewRGB(255, 0, 0), pterm.FgLightRed},
+		{pterm.NewRGB(0, 0, 230), pterm.FgBlue},
+		{pterm.NewRGB(90, 90, 250), pterm.FgLightBlue},
+		{pterm.NewRGB(128, 128, 128), pterm.FgDarkGray},
+		{pterm.NewRGB(230, 230, 230), pterm.FgWhite},
+		{pterm.NewRGB(255, 255, 255), pterm.FgLightWhite},
+	}
+	for _, tt := range tests {
+		testza.AssertEqual(t, tt.expected, tt.rgb.To16(), tt.rgb)
+	}
+}
@@ -3,7 +3,11 @@ package pterm
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"sync"
+
+	"github.com/pterm/pterm/internal"
 )
 
 // DefaultSection is the default section printer.
@@ -13,6 +17,10 @@ var DefaultSection = SectionPrinter{
 	TopPadding:      1,
 	BottomPadding:   1,
 	IndentCharacter: "#",
+	NumberStyle:     &ThemeDefault.SecondaryStyle,
+
+	BottomRuleCharacter: "─",
+	BottomRuleStyle:     &ThemeDefault.SecondaryStyle,
 }
 
 // SectionPrinter prints a new section title.
@@ -24,6 +32,45 @@ type SectionPrinter struct {
 	TopPadding      int
 	BottomPadding   int
 	Writer          io.Writer
+	// If AutoNumber is true, every section is prefixed with its number, for example "1.2".
+	// The numbering is shared between all printers derived from the printer returned by WithAutoNumber.
+	AutoNumber  bool
+	NumberStyle *Style
+	// Text is the title, which is rendered by Render and Srender.
+	Text string
+	// If BottomRule is true, a line of BottomRuleCharacter, which spans the terminal width, is printed under the title.
+	BottomRule          bool
+	BottomRuleCharacter string
+	BottomRuleStyle     *Style
+
+	numbering *sectionNumbering
+}
+
+// sectionNumbering contains the counters of every level of automatically numbered sections.
+type sectionNumbering struct {
+	mu       sync.Mutex
+	counters []int
+}
+
+// next increments the counter of the level, resets the counters of all deeper levels and returns the dotted number.
+func (n *sectionNumbering) next(level int) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if level < 1 {
+		level = 1
+	}
+	for len(n.counters) < level {
+		n.counters = append(n.counters, 0)
+	}
+	n.counters = n.counters[:level]
+	n.counters[level-1]++
+
+	numbers := make([]string, level)
+	for i, counter := range n.counters {
+		numbers[i] = strconv.Itoa(counter)
+	}
+	return strings.Join(numbers, ".")
 }
 
 // WithStyle returns a new SectionPrinter with a specific style.
@@ -56,6 +103,45 @@ func (p SectionPrinter) WithBottomPadding(padding int) *SectionPrinter {
 	return &p
 }
 
+// WithAutoNumber returns a new SectionPrinter, which numbers its sections automatically (1, 1.1, 1.2, 2, ...).
+// The level of a section is set with WithLevel. Printers derived from the returned printer share the numbering.
+func (p SectionPrinter) WithAutoNumber(b ...bool) *SectionPrinter {
+	p.AutoNumber = internal.WithBoolean(b)
+	p.numbering = &sectionNumbering{}
+	return &p
+}
+
+// WithNumberStyle returns a new SectionPrinter with a specific style for the section numbers.
+func (p SectionPrinter) WithNumberStyle(style *Style) *SectionPrinter {
+	p.NumberStyle = style
+	return &p
+}
+
+// WithText returns a new SectionPrinter with a specific title, which is rendered by Render and Srender.
+func (p SectionPrinter) WithText(text string) *SectionPrinter {
+	p.Text = text
+	return &p
+}
+
+// WithBottomRule returns a new SectionPrinter, which prints a line under the title, which spans the terminal width.
+// If the output is not a terminal, FallbackTerminalWidth is used.
+func (p SectionPrinter) WithBottomRule(b ...bool) *SectionPrinter {
+	p.BottomRule = internal.WithBoolean(b)
+	return &p
+}
+
+// WithBottomRuleCharacter returns a new SectionPrinter with a specific character for the bottom rule.
+func (p SectionPrinter) WithBottomRuleCharacter(char string) *SectionPrinter {
+	p.BottomRuleCharacter = char
+	return &p
+}
+
+// WithBottomRuleStyle returns a new SectionPrinter with a specific style for the bottom rule.
+func (p SectionPrinter) WithBottomRuleStyle(style *Style) *SectionPrinter {
+	p.BottomRuleStyle = style
+	return &p
+}
+
 // WithWriter sets the custom Writer.
 func (p SectionPrinter) WithWriter(writer io.Writer) *SectionPrinter {
 	p.Writer = writer
@@ -69,6 +155,9 @@ func (p SectionPrinter) Sprint(a ...interface{}) string {
 		p.Style = NewStyle()
 	}
 
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+
 	var ret string
 
 	for i := 0; i < p.TopPadding; i++ {
@@ -79,7 +168,24 @@ func (p SectionPrinter) Sprint(a ...interface{}) string {
 		ret += strings.Repeat(p.IndentCharacter, p.Level) + " "
 	}
 
-	ret += p.Style.Sprint(a...)
+	if p.AutoNumber {
+		if p.NumberStyle == nil {
+			p.NumberStyle = NewStyle()
+		}
+		if p.numbering == nil {
+			p.numbering = &sectionNumbering{}
+		}
+		ret += p.NumberStyle.Sprint(p.numbering.next(p.Level)) + " "
+	}
+
+	if p.BottomRule {
+		// the rule is printed directly under the title, also if the title ends with a newline, like in Sprintln
+		title := Sprint(a...)
+		trimmed := strings.TrimSuffix(title, "\n")
+		ret += p.Style.Sprint(trimmed) + "\n" + p.bottomRule() + title[len(trimmed):]
+	} else {
+		ret += p.Style.Sprint(a...)
+	}
 
 	for i := 0; i < p.BottomPadding; i++ {
 		ret += "\n"
@@ -88,6 +194,36 @@ func (p SectionPrinter) Sprint(a ...interface{}) string {
 	return ret
 }
 
+// bottomRule returns a line of BottomRuleCharacter, which spans the terminal width.
+func (p SectionPrinter) bottomRule() string {
+	char := p.BottomRuleCharacter
+	if char == "" {
+		char = "─"
+	}
+	charWidth := internal.DisplayWidth(char)
+	if charWidth < 1 {
+		charWidth = 1
+	}
+	style := p.BottomRuleStyle
+	if style == nil {
+		style = NewStyle()
+	}
+	return style.Sprint(strings.Repeat(char, GetTerminalWidth()/charWidth))
+}
+
+// Srender renders the Text of the SectionPrinter as a string.
+func (p SectionPrinter) Srender() (string, error) {
+	return p.Sprint(p.Text), nil
+}
+
+// Render prints the Text of the SectionPrinter to the terminal.
+// It returns the error of the Writer, if printing fails.
+func (p SectionPrinter) Render() error {
+	s, _ := p.Srender()
+	_, err := FprintE(p.Writer, s)
+	return err
+}
+
 // Sprintln formats using the default formats for its operands and returns the resulting string.
 // Spaces are always added between operands and a newline is appended.
 func (p SectionPrinter) Sprintln(a ...interface{}) string {
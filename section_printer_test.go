@@ -1,6 +1,7 @@
 package pterm_test
 
 import (
+	"bytes"
 	"errors"
 	"io"
 	"os"
@@ -145,3 +146,94 @@ func TestSectionPrinter_WithWriter(t *testing.T) {
 	testza.AssertEqual(t, s, p2.Writer)
 	testza.AssertZero(t, p.Writer)
 }
+
+func TestSectionPrinter_WithAutoNumber(t *testing.T) {
+	p := pterm.DefaultSection.WithAutoNumber().WithTopPadding(0).WithBottomPadding(0)
+
+	testza.AssertTrue(t, p.AutoNumber)
+	testza.AssertEqual(t, "# 1 Intro", pterm.RemoveColorFromString(p.Sprint("Intro")))
+	testza.AssertEqual(t, "## 1.1 First", pterm.RemoveColorFromString(p.WithLevel(2).Sprint("First")))
+	testza.AssertEqual(t, "## 1.2 Second", pterm.RemoveColorFromString(p.WithLevel(2).Sprint("Second")))
+	testza.AssertEqual(t, "### 1.2.1 Detail", pterm.RemoveColorFromString(p.WithLevel(3).Sprint("Detail")))
+	testza.AssertEqual(t, "# 2 Next", pterm.RemoveColorFromString(p.Sprint("Next")))
+	testza.AssertEqual(t, "## 2.1 Reset", pterm.RemoveColorFromString(p.WithLevel(2).Sprint("Reset")))
+}
+
+func TestSectionPrinter_WithAutoNumberStartsNewNumbering(t *testing.T) {
+	p := pterm.DefaultSection.WithAutoNumber().WithTopPadding(0).WithBottomPadding(0)
+	p.Sprint("First")
+	p2 := p.WithAutoNumber()
+	testza.AssertEqual(t, "# 1 Other", pterm.RemoveColorFromString(p2.Sprint("Other")))
+}
+
+func TestSectionPrinter_WithNumberStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultSection.WithAutoNumber().WithNumberStyle(s)
+
+	testza.AssertEqual(t, s, p.NumberStyle)
+	testza.AssertContains(t, p.Sprint("Title"), s.Sprint("1"))
+}
+
+func TestSectionPrinter_WithText(t *testing.T) {
+	p := pterm.DefaultSection.WithText("Title")
+
+	testza.AssertEqual(t, "Title", p.Text)
+	testza.AssertZero(t, pterm.DefaultSection.Text)
+}
+
+func TestSectionPrinter_Srender(t *testing.T) {
+	p := pterm.DefaultSection.WithText("Report")
+	s, err := p.Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, p.Sprint("Report"), s)
+	testza.AssertEqual(t, "\n# Report\n", pterm.RemoveColorFromString(s))
+}
+
+func TestSectionPrinter_Render(t *testing.T) {
+	var buf bytes.Buffer
+	err := pterm.DefaultSection.WithText("Report").WithWriter(&buf).Render()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "\n# Report\n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestSectionPrinter_RenderWriteError(t *testing.T) {
+	err := pterm.DefaultSection.WithText("Report").WithWriter(failingWriter{}).Render()
+
+	testza.AssertEqual(t, "write failed", err.Error())
+}
+
+func TestSectionPrinter_WithBottomRule(t *testing.T) {
+	w, h := pterm.GetTerminalWidth(), pterm.GetTerminalHeight()
+	pterm.SetForcedTerminalSize(10, h)
+	defer pterm.SetForcedTerminalSize(w, h)
+
+	p := pterm.DefaultSection.WithBottomRule().WithTopPadding(0).WithBottomPadding(0)
+	testza.AssertTrue(t, p.BottomRule)
+	testza.AssertEqual(t, "# Title\n──────────", pterm.RemoveColorFromString(p.Sprint("Title")))
+	testza.AssertEqual(t, "# Title\n──────────\n", pterm.RemoveColorFromString(p.Sprintln("Title")))
+}
+
+func TestSectionPrinter_WithBottomRuleCharacter(t *testing.T) {
+	w, h := pterm.GetTerminalWidth(), pterm.GetTerminalHeight()
+	pterm.SetForcedTerminalSize(7, h)
+	defer pterm.SetForcedTerminalSize(w, h)
+
+	p := pterm.DefaultSection.WithBottomRule().WithBottomRuleCharacter("═").WithText("Title").WithTopPadding(0)
+	s, _ := p.Srender()
+	testza.AssertEqual(t, "═", p.BottomRuleCharacter)
+	testza.AssertEqual(t, "# Title\n═══════\n", pterm.RemoveColorFromString(s))
+
+	// wide characters are counted with their display width
+	s, _ = p.WithBottomRuleCharacter("一").Srender()
+	testza.AssertEqual(t, "# Title\n一一一\n", pterm.RemoveColorFromString(s))
+}
+
+func TestSectionPrinter_WithBottomRuleStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultSection.WithBottomRule().WithBottomRuleStyle(s)
+
+	testza.AssertEqual(t, s, p.BottomRuleStyle)
+	testza.AssertContains(t, p.Sprint("Title"), "\x1b[31m─")
+}
@@ -1,12 +1,17 @@
 package pterm
 
 import (
+	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/pterm/pterm/internal"
+	"github.com/gookit/color"
+	"github.com/mattn/go-runewidth"
 	"go.uber.org/atomic"
+
+	"github.com/pterm/pterm/internal"
 )
 
 type atomicActiveSpinnerPrinters struct {
@@ -14,6 +19,17 @@ type atomicActiveSpinnerPrinters struct {
 	lock     *sync.Mutex
 }
 
+var (
+	// SpinnerSequenceDots is a spinner sequence of rotating braille dots.
+	SpinnerSequenceDots = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	// SpinnerSequenceLine is a spinner sequence of a rotating line.
+	SpinnerSequenceLine = []string{"-", "\\", "|", "/"}
+	// SpinnerSequenceBounce is a spinner sequence of a bouncing dot.
+	SpinnerSequenceBounce = []string{"[●    ]", "[ ●   ]", "[  ●  ]", "[   ● ]", "[    ●]", "[   ● ]", "[  ●  ]", "[ ●   ]"}
+	// SpinnerSequenceClock is a spinner sequence of clock emojis.
+	SpinnerSequenceClock = []string{"🕛", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚"}
+)
+
 var (
 	// DefaultSpinner is the default SpinnerPrinter.
 	DefaultSpinner = SpinnerPrinter{
@@ -53,6 +69,10 @@ type SpinnerPrinter struct {
 	ShowTimer           bool
 	TimerRoundingFactor time.Duration
 	TimerStyle          *Style
+	// Timeout fails the SpinnerPrinter with the TimeoutMessage, if it isn't stopped in time. Zero disables the timeout.
+	Timeout time.Duration
+	// TimeoutMessage is shown by the FailPrinter, if the Timeout is reached. If it is empty, the text of the SpinnerPrinter is used.
+	TimeoutMessage string
 
 	IsActive bool
 
@@ -63,6 +83,15 @@ type SpinnerPrinter struct {
 	atomicIsActive *atomic.Bool
 	atomicText     *atomic.String
 
+	// finished is set once a started spinner resolved into a final state.
+	finished *atomic.Bool
+	// renderLock prevents animation frames from overwriting the final state.
+	renderLock *sync.Mutex
+	// line is the line of the spinner, if other spinners are shown on the same writer.
+	line *spinnerLine
+	// timeoutTimer fails the spinner after the Timeout. It is guarded by renderLock.
+	timeoutTimer *time.Timer
+
 	Writer io.Writer
 }
 
@@ -77,6 +106,12 @@ func (s *SpinnerPrinter) lazyInit() {
 	if s.currentSequence == nil {
 		s.currentSequence = atomic.NewString("")
 	}
+	if s.finished == nil {
+		s.finished = atomic.NewBool(false)
+	}
+	if s.renderLock == nil {
+		s.renderLock = &sync.Mutex{}
+	}
 }
 
 // WithText adds a text to the SpinnerPrinter.
@@ -89,6 +124,7 @@ func (s SpinnerPrinter) WithText(text string) *SpinnerPrinter {
 }
 
 // WithSequence adds a sequence to the SpinnerPrinter.
+// Predefined sequences are SpinnerSequenceDots, SpinnerSequenceLine, SpinnerSequenceBounce and SpinnerSequenceClock.
 func (s SpinnerPrinter) WithSequence(sequence ...string) *SpinnerPrinter {
 	s.lazyInit()
 	s.Sequence = sequence
@@ -144,6 +180,18 @@ func (s SpinnerPrinter) WithTimerStyle(style *Style) *SpinnerPrinter {
 	return &s
 }
 
+// WithTimeout sets the time, after which the SpinnerPrinter fails, if it isn't stopped.
+func (s SpinnerPrinter) WithTimeout(timeout time.Duration) *SpinnerPrinter {
+	s.Timeout = timeout
+	return &s
+}
+
+// WithTimeoutMessage sets the message, which is shown, if the Timeout is reached.
+func (s SpinnerPrinter) WithTimeoutMessage(message string) *SpinnerPrinter {
+	s.TimeoutMessage = message
+	return &s
+}
+
 // WithWriter sets the custom Writer.
 func (s SpinnerPrinter) WithWriter(writer io.Writer) *SpinnerPrinter {
 	s.lazyInit()
@@ -158,9 +206,15 @@ func (s *SpinnerPrinter) UpdateText(text string) {
 	s.atomicText.Store(text)
 	// We still set Text here so it is available to the users, it is not read anywhere
 	s.Text = text
+
+	s.renderLock.Lock()
+	defer s.renderLock.Unlock()
+	if s.finished.Load() {
+		return
+	}
 	if !RawOutput.Load() {
-		fClearLine(s.Writer)
-		Fprinto(s.Writer, s.Style.Sprint(s.currentSequence.Load())+" "+s.MessageStyle.Sprint(s.atomicText.Load()))
+		s.clearLine()
+		s.printLine(s.Style.Sprint(s.currentSequence.Load()) + " " + s.MessageStyle.Sprint(s.atomicText.Load()))
 	}
 	if RawOutput.Load() {
 		Fprintln(s.Writer, s.atomicText.Load())
@@ -168,9 +222,14 @@ func (s *SpinnerPrinter) UpdateText(text string) {
 }
 
 // Start the SpinnerPrinter.
+// A started SpinnerPrinter has to be stopped with Stop, or resolved with Success, Fail, Info or Warning.
+// Until then, it keeps rendering, and other spinners on the same writer are shown below it.
 func (s SpinnerPrinter) Start(text ...interface{}) (*SpinnerPrinter, error) {
 	s.lazyInit()
-	s.atomicIsActive.Store(true)
+	// Each run gets its own state, so copies of a finished spinner can be started again.
+	s.atomicIsActive = atomic.NewBool(true)
+	s.finished = atomic.NewBool(false)
+	s.renderLock = &sync.Mutex{}
 	s.IsActive = true
 	// We still set IsActive here so it is available to the users, it is not read anywhere
 	s.startedAt = time.Now()
@@ -184,12 +243,24 @@ func (s SpinnerPrinter) Start(text ...interface{}) (*SpinnerPrinter, error) {
 	}
 
 	if RawOutput.Load() {
+		s.line = nil
 		Fprintln(s.Writer, s.atomicText.Load())
+	} else {
+		s.line = addSpinnerLine(s.Writer, s.atomicIsActive)
+	}
+
+	if s.Timeout > 0 {
+		s.renderLock.Lock()
+		s.timeoutTimer = time.AfterFunc(s.Timeout, s.timeout)
+		s.renderLock.Unlock()
 	}
 
+	sequence := padSpinnerSequence(s.Sequence)
+
 	go func() {
+		defer s.releaseLine()
 		for s.atomicIsActive.Load() {
-			for _, seq := range s.Sequence {
+			for _, seq := range sequence {
 				if !s.atomicIsActive.Load() || RawOutput.Load() {
 					continue
 				}
@@ -198,9 +269,16 @@ func (s SpinnerPrinter) Start(text ...interface{}) (*SpinnerPrinter, error) {
 				if s.ShowTimer {
 					timer = " (" + time.Since(s.startedAt).Round(s.TimerRoundingFactor).String() + ")"
 				}
-				fClearLine(s.Writer)
-				Fprinto(s.Writer, s.Style.Sprint(seq)+" "+s.MessageStyle.Sprint(s.atomicText.Load())+s.TimerStyle.Sprint(timer))
-				s.currentSequence.Store(seq)
+				themeLock.RLock()
+				frame := s.Style.Sprint(seq) + " " + s.MessageStyle.Sprint(s.atomicText.Load()) + s.TimerStyle.Sprint(timer)
+				themeLock.RUnlock()
+				s.renderLock.Lock()
+				if s.atomicIsActive.Load() {
+					// Clear to the end of the line, so that no characters of a longer previous frame remain.
+					s.printLine(frame + "\x1b[K")
+					s.currentSequence.Store(seq)
+				}
+				s.renderLock.Unlock()
 				time.Sleep(s.Delay)
 			}
 		}
@@ -208,21 +286,90 @@ func (s SpinnerPrinter) Start(text ...interface{}) (*SpinnerPrinter, error) {
 	return &s, nil
 }
 
+// padSpinnerSequence pads all frames to the display width of the widest frame,
+// so that the message does not move when frames of different widths are shown.
+func padSpinnerSequence(sequence []string) []string {
+	var maxWidth int
+	for _, frame := range sequence {
+		if w := runewidth.StringWidth(RemoveColorFromString(frame)); w > maxWidth {
+			maxWidth = w
+		}
+	}
+
+	padded := make([]string, len(sequence))
+	for i, frame := range sequence {
+		padded[i] = frame + strings.Repeat(" ", maxWidth-runewidth.StringWidth(RemoveColorFromString(frame)))
+	}
+	return padded
+}
+
 // Stop terminates the SpinnerPrinter immediately.
 // The SpinnerPrinter will not resolve into anything.
 func (s *SpinnerPrinter) Stop() error {
 	s.lazyInit()
+	s.renderLock.Lock()
+	defer s.renderLock.Unlock()
+	s.stop()
+	return nil
+}
+
+// stop terminates the animation and moves the cursor to the start of the next line.
+// The caller must hold renderLock.
+func (s *SpinnerPrinter) stop() {
 	if !s.atomicIsActive.Load() {
-		return nil
+		return
 	}
 	s.atomicIsActive.Store(false)
+	s.IsActive = false
+	if s.timeoutTimer != nil {
+		s.timeoutTimer.Stop()
+		s.timeoutTimer = nil
+	}
+
+	activeSpinnerPrinters.lock.Lock()
+	active := activeSpinnerPrinters.printers[:0]
+	for _, spinner := range activeSpinnerPrinters.printers {
+		if spinner.renderLock != s.renderLock {
+			active = append(active, spinner)
+		}
+	}
+	activeSpinnerPrinters.printers = active
+	activeSpinnerPrinters.lock.Unlock()
+
 	if s.RemoveWhenDone {
-		fClearLine(s.Writer)
-		Fprinto(s.Writer)
-	} else {
+		s.clearLine()
+		s.printLine("")
+	}
+	if s.line != nil && !s.line.remove(s.RemoveWhenDone) {
+		// Other spinners are still shown, so the cursor stays at the last line of the block.
+		return
+	}
+	if !s.RemoveWhenDone {
 		Fprintln(s.Writer)
 	}
-	return nil
+}
+
+// releaseLine removes the line of the spinner, if the spinner stopped rendering without being stopped.
+func (s *SpinnerPrinter) releaseLine() {
+	s.renderLock.Lock()
+	defer s.renderLock.Unlock()
+	if s.line != nil {
+		s.line.release()
+	}
+}
+
+// printLine overrides the line of the spinner.
+func (s *SpinnerPrinter) printLine(text string) {
+	if s.line == nil {
+		Fprinto(s.Writer, text)
+		return
+	}
+	s.line.print(text)
+}
+
+// clearLine clears the line of the spinner.
+func (s *SpinnerPrinter) clearLine() {
+	s.printLine(strings.Repeat(" ", GetTerminalWidth()))
 }
 
 // GenericStart runs Start, but returns a LivePrinter.
@@ -248,63 +395,219 @@ func (s *SpinnerPrinter) GenericStop() (*LivePrinter, error) {
 // Info displays an info message
 // If no message is given, the text of the SpinnerPrinter will be reused as the default message.
 func (s *SpinnerPrinter) Info(message ...interface{}) {
-	s.lazyInit()
 	if s.InfoPrinter == nil {
 		s.InfoPrinter = &Info
 	}
-
-	if len(message) == 0 {
-		message = []interface{}{s.atomicText.Load()}
-	}
-	fClearLine(s.Writer)
-	Fprinto(s.Writer, s.InfoPrinter.Sprint(message...))
-	_ = s.Stop()
+	s.finish(s.InfoPrinter, message)
 }
 
 // Success displays the success printer.
 // If no message is given, the text of the SpinnerPrinter will be reused as the default message.
 func (s *SpinnerPrinter) Success(message ...interface{}) {
-	s.lazyInit()
 	if s.SuccessPrinter == nil {
 		s.SuccessPrinter = &Success
 	}
-
-	if len(message) == 0 {
-		message = []interface{}{s.atomicText.Load()}
-	}
-	fClearLine(s.Writer)
-	Fprinto(s.Writer, s.SuccessPrinter.Sprint(message...))
-	_ = s.Stop()
+	s.finish(s.SuccessPrinter, message)
 }
 
 // Fail displays the fail printer.
 // If no message is given, the text of the SpinnerPrinter will be reused as the default message.
 func (s *SpinnerPrinter) Fail(message ...interface{}) {
-	s.lazyInit()
 	if s.FailPrinter == nil {
 		s.FailPrinter = &Error
 	}
-
-	if len(message) == 0 {
-		message = []interface{}{s.atomicText.Load()}
-	}
-	fClearLine(s.Writer)
-	Fprinto(s.Writer, s.FailPrinter.Sprint(message...))
-	_ = s.Stop()
+	s.finish(s.FailPrinter, message)
 }
 
 // Warning displays the warning printer.
 // If no message is given, the text of the SpinnerPrinter will be reused as the default message.
 func (s *SpinnerPrinter) Warning(message ...interface{}) {
-	s.lazyInit()
 	if s.WarningPrinter == nil {
 		s.WarningPrinter = &Warning
 	}
+	s.finish(s.WarningPrinter, message)
+}
+
+// timeout fails the spinner with the TimeoutMessage, if it is still active.
+func (s *SpinnerPrinter) timeout() {
+	s.renderLock.Lock()
+	defer s.renderLock.Unlock()
+
+	if !s.atomicIsActive.Load() {
+		return
+	}
+	printer := s.FailPrinter
+	if printer == nil {
+		printer = &Error
+	}
+	var message []interface{}
+	if s.TimeoutMessage != "" {
+		message = []interface{}{s.TimeoutMessage}
+	}
+	s.finishLocked(printer, message)
+}
+
+// finish replaces the spinner with the final message and stops it.
+// Once a started spinner is finished, further calls are ignored, so that Fail after Success does nothing.
+func (s *SpinnerPrinter) finish(printer TextPrinter, message []interface{}) {
+	s.lazyInit()
+	s.renderLock.Lock()
+	defer s.renderLock.Unlock()
+
+	s.finishLocked(printer, message)
+}
+
+// finishLocked is like finish. The caller must hold renderLock.
+func (s *SpinnerPrinter) finishLocked(printer TextPrinter, message []interface{}) {
+	if s.finished.Load() {
+		return
+	}
+	if s.atomicIsActive.Load() {
+		s.finished.Store(true)
+	}
 
 	if len(message) == 0 {
 		message = []interface{}{s.atomicText.Load()}
 	}
-	fClearLine(s.Writer)
-	Fprinto(s.Writer, s.WarningPrinter.Sprint(message...))
-	_ = s.Stop()
+	text := printer.Sprint(message...)
+	s.clearLine()
+	s.printLine(text)
+	s.stop()
+}
+
+// spinnerLines contains the lines of the spinners, which are shown at the same time on the same writer.
+// A block of lines is removed, once all of its spinners are stopped.
+// Lines of spinners, which are no longer active, are removed, when the next spinner is started.
+// It is guarded by pLock.
+var spinnerLines []*spinnerLine
+
+// spinnerLine is the line of a spinner in a block of spinners on the same writer.
+// The cursor is always kept at the last line of the block.
+type spinnerLine struct {
+	writer  io.Writer
+	index   int
+	text    string
+	stopped bool
+	removed bool
+	// active is the state of the spinner, which is shown in the line.
+	active *atomic.Bool
+}
+
+// addSpinnerLine adds a line for a started spinner.
+// If other spinners are shown on the writer, a new line is started below them.
+func addSpinnerLine(writer io.Writer, active *atomic.Bool) *spinnerLine {
+	pLock.Lock()
+	defer pLock.Unlock()
+
+	pruneSpinnerLines()
+	block := spinnerBlock(writer)
+	if len(block) > 0 && Output.Load() {
+		_, _ = write(writer, "\n")
+	}
+	line := &spinnerLine{writer: writer, index: len(block), active: active}
+	spinnerLines = append(spinnerLines, line)
+	return line
+}
+
+// spinnerBlock returns the lines of the spinners on the writer.
+// The caller has to hold pLock.
+func spinnerBlock(writer io.Writer) []*spinnerLine {
+	var block []*spinnerLine
+	for _, line := range spinnerLines {
+		if sameOutput(line.writer, writer) {
+			block = append(block, line)
+		}
+	}
+	return block
+}
+
+// print overrides the line with the text.
+// If the line is not the last line of the block, the cursor is moved up to it and back down afterwards.
+func (l *spinnerLine) print(text string) {
+	pLock.Lock()
+	defer pLock.Unlock()
+
+	l.text = text
+	if !Output.Load() {
+		return
+	}
+	up := len(spinnerBlock(l.writer)) - 1 - l.index
+	if up <= 0 {
+		_, _ = write(l.writer, "\r"+color.Sprint(text))
+		return
+	}
+	_, _ = write(l.writer, fmt.Sprintf("\x1b[%dA\r%s\x1b[%dB", up, color.Sprint(text), up))
+}
+
+// remove marks the line as stopped. The text of the line stays, unless the line was removed.
+// It returns true, if all spinners of the block are stopped, and the block was removed.
+// The cursor is then at the last line of the block.
+func (l *spinnerLine) remove(removed bool) bool {
+	pLock.Lock()
+	defer pLock.Unlock()
+
+	l.stopped = true
+	l.removed = removed
+	return removeSpinnerBlock(l.writer, false)
+}
+
+// release marks the line as stopped, if it wasn't stopped yet.
+func (l *spinnerLine) release() {
+	pLock.Lock()
+	defer pLock.Unlock()
+
+	if l.stopped {
+		return
+	}
+	l.stopped = true
+	removeSpinnerBlock(l.writer, false)
+}
+
+// removeSpinnerBlock removes the lines of the spinners on the writer, if all of them are stopped.
+// If inactive is true, lines of spinners, which are no longer active, count as stopped.
+// The caller has to hold pLock.
+func removeSpinnerBlock(writer io.Writer, inactive bool) bool {
+	for _, line := range spinnerBlock(writer) {
+		if !line.stopped && (!inactive || line.active.Load()) {
+			return false
+		}
+	}
+
+	lines := spinnerLines[:0]
+	for _, line := range spinnerLines {
+		if !sameOutput(line.writer, writer) {
+			lines = append(lines, line)
+		}
+	}
+	spinnerLines = lines
+	return true
+}
+
+// pruneSpinnerLines removes the blocks, whose spinners are all stopped or no longer active.
+// The caller has to hold pLock.
+func pruneSpinnerLines() {
+	for i := 0; i < len(spinnerLines); {
+		if removeSpinnerBlock(spinnerLines[i].writer, true) {
+			continue
+		}
+		i++
+	}
+}
+
+// printAboveSpinnerBlock returns the message, so that it is printed above the spinners on the writer,
+// if more than one spinner is shown. The lines of the spinners are printed again below the message.
+// The caller has to hold pLock.
+func printAboveSpinnerBlock(writer io.Writer, message string) (string, bool) {
+	block := spinnerBlock(writer)
+	if len(block) < 2 {
+		return message, false
+	}
+	if !strings.HasSuffix(message, "\n") {
+		message += "\n"
+	}
+	lines := make([]string, len(block))
+	for i, line := range block {
+		lines[i] = color.Sprint(line.text)
+	}
+	return fmt.Sprintf("\x1b[%dA\r\x1b[J", len(block)-1) + message + strings.Join(lines, "\n"), true
 }
@@ -3,8 +3,12 @@ package pterm_test
 import (
 	"io"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/MarvinJWendt/testza"
 	"github.com/pterm/pterm"
@@ -61,18 +65,20 @@ func TestSpinnerPrinter_Success(t *testing.T) {
 func TestSpinnerPrinter_UpdateText(t *testing.T) {
 	t.Run("Simple", func(t *testing.T) {
 		p := pterm.DefaultSpinner
-		p.Start()
+		sp, _ := p.Start()
 		p.UpdateText("test")
 
 		testza.AssertEqual(t, "test", p.Text)
+		sp.Stop()
 	})
 
 	t.Run("Override", func(t *testing.T) {
 		out := captureStdout(func(io.Writer) {
 			// Set a really long delay to make sure text doesn't get updated before function returns.
 			p := pterm.DefaultSpinner.WithDelay(1 * time.Hour)
-			p.Start("An initial long message")
+			sp, _ := p.Start("An initial long message")
 			p.UpdateText("A short message")
+			sp.Stop()
 		})
 		testza.AssertContains(t, out, "A short message")
 	})
@@ -81,11 +87,11 @@ func TestSpinnerPrinter_UpdateText(t *testing.T) {
 func TestSpinnerPrinter_UpdateTextRawOutput(t *testing.T) {
 	pterm.DisableStyling()
 	p := pterm.DefaultSpinner
-	p.Start()
+	sp, _ := p.Start()
 	p.UpdateText("test")
 
 	testza.AssertEqual(t, "test", p.Text)
-	p.Stop()
+	sp.Stop()
 	pterm.EnableStyling()
 }
 
@@ -214,8 +220,8 @@ func TestSpinnerPrinter_DifferentVariations(t *testing.T) {
 				RemoveWhenDone: tt.fields.RemoveWhenDone,
 				IsActive:       tt.fields.IsActive,
 			}
-			s.Start(tt.args.text)
-			s.Stop()
+			sp, _ := s.Start(tt.args.text)
+			sp.Stop()
 		})
 	}
 }
@@ -271,3 +277,234 @@ func TestSpinnerPrinter_OutputToWriters(t *testing.T) {
 // func TestClearActiveSpinners(t *testing.T) {
 // 	activeSpinnerPrinters = []*pterm.SpinnerPrinter{}
 // }
+
+func TestSpinnerPrinter_SequencePresets(t *testing.T) {
+	for _, sequence := range [][]string{pterm.SpinnerSequenceDots, pterm.SpinnerSequenceLine, pterm.SpinnerSequenceBounce, pterm.SpinnerSequenceClock} {
+		testza.AssertGreater(t, len(sequence), 1)
+		p := pterm.DefaultSpinner.WithSequence(sequence...)
+		testza.AssertEqual(t, sequence, p.Sequence)
+	}
+}
+
+func TestSpinnerPrinter_FramesOfDifferentWidths(t *testing.T) {
+	w := &frameWriter{frames: 2, done: make(chan struct{})}
+	sp, _ := pterm.DefaultSpinner.WithSequence("🕛", "-").WithDelay(time.Millisecond).WithShowTimer(false).WithWriter(w).Start("msg")
+	select {
+	case <-w.done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("frames were not rendered: %q", w.String())
+	}
+	sp.Stop()
+
+	out := pterm.RemoveColorFromString(w.String())
+	testza.AssertContains(t, out, "\r🕛 msg\x1b[K")
+	testza.AssertContains(t, out, "\r-  msg\x1b[K")
+}
+
+// frameWriter closes done, once the given number of frames were written.
+// It doesn't call pterm functions, because it is written to while the output lock is held.
+type frameWriter struct {
+	Buffer
+	frames int
+	done   chan struct{}
+}
+
+func (w *frameWriter) Write(p []byte) (int, error) {
+	n, err := w.Buffer.Write(p)
+	if strings.HasSuffix(string(p), "\x1b[K") {
+		w.m.Lock()
+		w.frames--
+		if w.frames == 0 {
+			close(w.done)
+		}
+		w.m.Unlock()
+	}
+	return n, err
+}
+
+func TestSpinnerPrinter_FinalStateIsIdempotent(t *testing.T) {
+	var buf Buffer
+	sp, _ := pterm.DefaultSpinner.WithDelay(10 * time.Millisecond).WithWriter(&buf).Start("msg")
+	time.Sleep(50 * time.Millisecond)
+	sp.Success("done")
+	sp.Fail("failed")
+	sp.Warning("warned")
+	time.Sleep(50 * time.Millisecond)
+
+	out := buf.String()
+	testza.AssertContains(t, out, "done")
+	testza.AssertNotContains(t, out, "failed")
+	testza.AssertNotContains(t, out, "warned")
+	testza.AssertTrue(t, strings.HasSuffix(out, "\n"))
+	testza.AssertFalse(t, sp.IsActive)
+}
+
+func TestSpinnerPrinter_FinalStateIsNotOverwritten(t *testing.T) {
+	var buf Buffer
+	sp, _ := pterm.DefaultSpinner.WithDelay(time.Millisecond).WithWriter(&buf).Start("msg")
+	time.Sleep(20 * time.Millisecond)
+	sp.Fail("failed")
+	time.Sleep(20 * time.Millisecond)
+
+	out := pterm.RemoveColorFromString(buf.String())
+	testza.AssertTrue(t, strings.HasSuffix(out, "failed\n"), out)
+}
+
+// terminalEscapeRegex matches the escape sequences, which are interpreted by renderTerminal.
+var terminalEscapeRegex = regexp.MustCompile(`^\x1b\[(\d*)([ABJKm])`)
+
+// renderTerminal returns the lines, which a terminal shows after printing s.
+// Only carriage returns, newlines, cursor up and down, and clearing sequences are interpreted. Colors are removed.
+func renderTerminal(s string) []string {
+	lines := [][]rune{{}}
+	row, col := 0, 0
+	for i := 0; i < len(s); {
+		if m := terminalEscapeRegex.FindStringSubmatch(s[i:]); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			switch m[2] {
+			case "A":
+				row -= n
+			case "B":
+				row += n
+				for len(lines) <= row {
+					lines = append(lines, []rune{})
+				}
+			case "J":
+				lines = lines[:row+1]
+				fallthrough
+			case "K":
+				if col < len(lines[row]) {
+					lines[row] = lines[row][:col]
+				}
+			}
+			i += len(m[0])
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		switch r {
+		case '\r':
+			col = 0
+		case '\n':
+			row, col = row+1, 0
+			if len(lines) <= row {
+				lines = append(lines, []rune{})
+			}
+		default:
+			for len(lines[row]) <= col {
+				lines[row] = append(lines[row], ' ')
+			}
+			lines[row][col] = r
+			col++
+		}
+	}
+
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = strings.TrimRight(string(line), " ")
+	}
+	return result
+}
+
+func TestSpinnerPrinter_ConcurrentSpinners(t *testing.T) {
+	var buf Buffer
+	p := pterm.DefaultSpinner.WithShowTimer(false).WithDelay(5 * time.Millisecond).WithWriter(&buf)
+	first, _ := p.Start("first")
+	second, _ := p.Start("second")
+	time.Sleep(30 * time.Millisecond)
+
+	first.Success("first done")
+	time.Sleep(20 * time.Millisecond)
+	second.Fail("second failed")
+
+	lines := renderTerminal(buf.String())
+	testza.AssertLen(t, lines, 3)
+	testza.AssertContains(t, lines[0], "first done")
+	testza.AssertContains(t, lines[1], "second failed")
+	testza.AssertEqual(t, "", lines[2])
+}
+
+func TestSpinnerPrinter_ConcurrentSpinnersStopInReverseOrder(t *testing.T) {
+	var buf Buffer
+	p := pterm.DefaultSpinner.WithShowTimer(false).WithDelay(5 * time.Millisecond).WithWriter(&buf)
+	first, _ := p.Start("first")
+	second, _ := p.Start("second")
+	third, _ := p.WithRemoveWhenDone().Start("third")
+	time.Sleep(30 * time.Millisecond)
+
+	third.Stop()
+	second.Success("second done")
+	time.Sleep(20 * time.Millisecond)
+	first.Success("first done")
+
+	lines := renderTerminal(buf.String())
+	testza.AssertContains(t, lines[0], "first done")
+	testza.AssertContains(t, lines[1], "second done")
+	testza.AssertNotContains(t, strings.Join(lines[2:], "\n"), "third")
+}
+
+func TestSpinnerPrinter_StoppedBlockIsRemoved(t *testing.T) {
+	var buf Buffer
+	p := pterm.DefaultSpinner.WithShowTimer(false).WithDelay(time.Hour).WithWriter(&buf)
+	first, _ := p.Start("first")
+	second, _ := p.Start("second")
+	second.Success("second done")
+	first.Success("first done")
+
+	buf.Reset()
+	third, _ := p.Start("third")
+	third.Success("third done")
+
+	testza.AssertFalse(t, strings.HasPrefix(buf.String(), "\n"))
+	lines := renderTerminal(buf.String())
+	testza.AssertLen(t, lines, 2)
+	testza.AssertContains(t, lines[0], "third done")
+}
+
+func TestSpinnerPrinter_ConcurrentSpinnersPrintAbove(t *testing.T) {
+	var buf Buffer
+	p := pterm.DefaultSpinner.WithShowTimer(false).WithDelay(time.Hour).WithWriter(&buf)
+	first, _ := p.Start("first")
+	second, _ := p.Start("second")
+
+	pterm.Fprintln(&buf, "log message")
+	first.Success("first done")
+	second.Success("second done")
+
+	lines := renderTerminal(buf.String())
+	testza.AssertLen(t, lines, 4)
+	testza.AssertEqual(t, "log message", lines[0])
+	testza.AssertContains(t, lines[1], "first done")
+	testza.AssertContains(t, lines[2], "second done")
+}
+
+func TestSpinnerPrinter_WithTimeout(t *testing.T) {
+	p := pterm.DefaultSpinner.WithTimeout(time.Second).WithTimeoutMessage("timed out")
+	testza.AssertEqual(t, time.Second, p.Timeout)
+	testza.AssertEqual(t, "timed out", p.TimeoutMessage)
+	testza.AssertZero(t, pterm.DefaultSpinner.Timeout)
+}
+
+func TestSpinnerPrinter_TimeoutFails(t *testing.T) {
+	var buf Buffer
+	spinner, _ := pterm.DefaultSpinner.WithWriter(&buf).WithDelay(time.Millisecond).
+		WithTimeout(20 * time.Millisecond).WithTimeoutMessage("resource not ready").Start("Waiting")
+	time.Sleep(100 * time.Millisecond)
+
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "ERROR   resource not ready")
+	// the spinner is already stopped, so nothing is printed anymore
+	written := buf.String()
+	spinner.Success("done")
+	testza.AssertEqual(t, written, buf.String())
+}
+
+func TestSpinnerPrinter_StopCancelsTimeout(t *testing.T) {
+	var buf Buffer
+	spinner, _ := pterm.DefaultSpinner.WithWriter(&buf).WithDelay(time.Millisecond).
+		WithTimeout(20 * time.Millisecond).WithTimeoutMessage("resource not ready").Start("Waiting")
+	spinner.Success("ready")
+	time.Sleep(50 * time.Millisecond)
+
+	testza.AssertContains(t, buf.String(), "ready")
+	testza.AssertNotContains(t, buf.String(), "resource not ready")
+}
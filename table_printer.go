@@ -2,7 +2,12 @@ package pterm
 
 import (
 	"encoding/csv"
+	"fmt"
+	"html"
 	"io"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/mattn/go-runewidth"
@@ -15,10 +20,14 @@ var DefaultTable = TablePrinter{
 	HeaderStyle:             &ThemeDefault.TableHeaderStyle,
 	HeaderRowSeparator:      "",
 	HeaderRowSeparatorStyle: &ThemeDefault.TableSeparatorStyle,
+	FooterStyle:             &ThemeDefault.TableFooterStyle,
+	FooterRowSeparator:      "-",
+	FooterRowSeparatorStyle: &ThemeDefault.TableSeparatorStyle,
 	Separator:               " | ",
 	SeparatorStyle:          &ThemeDefault.TableSeparatorStyle,
 	RowSeparator:            "",
 	RowSeparatorStyle:       &ThemeDefault.TableSeparatorStyle,
+	RowSpanStyle:            &ThemeDefault.TableRowSpanStyle,
 	LeftAlignment:           true,
 	RightAlignment:          false,
 }
@@ -26,6 +35,18 @@ var DefaultTable = TablePrinter{
 // TableData is the type that contains the data of a TablePrinter.
 type TableData [][]string
 
+// TableCellOverflow defines how a TablePrinter handles cells, which are wider than the MaxColumnWidth.
+type TableCellOverflow int
+
+const (
+	// OverflowNone prints the cells in full width.
+	OverflowNone TableCellOverflow = iota
+	// OverflowWrap wraps the cells into multiple lines.
+	OverflowWrap
+	// OverflowTruncate cuts the cells and appends "…".
+	OverflowTruncate
+)
+
 // TablePrinter is able to render tables.
 type TablePrinter struct {
 	Style                   *Style
@@ -33,11 +54,28 @@ type TablePrinter struct {
 	HeaderStyle             *Style
 	HeaderRowSeparator      string
 	HeaderRowSeparatorStyle *Style
+	HasFooter               bool
+	FooterStyle             *Style
+	FooterRowSeparator      string
+	FooterRowSeparatorStyle *Style
 	Separator               string
 	SeparatorStyle          *Style
 	RowSeparator            string
 	RowSeparatorStyle       *Style
+	EvenRowStyle            *Style
+	OddRowStyle             *Style
+	BackgroundColor         *RGB
+	RowSpans                map[int]bool
+	RowSpanStyle            *Style
+	RowSpanCentered         bool
+	SortColumn              *int
+	SortDescending          bool
 	Data                    TableData
+	LinkColumns             []int
+	CellStyler              func(row, col int, content string) *Style
+	MaxColumnWidth          int
+	CellOverflow            TableCellOverflow
+	VerticalCenter          bool
 	Boxed                   bool
 	LeftAlignment           bool
 	RightAlignment          bool
@@ -74,6 +112,30 @@ func (p TablePrinter) WithHeaderRowSeparatorStyle(style *Style) *TablePrinter {
 	return &p
 }
 
+// WithHasFooter returns a new TablePrinter, where the last line is marked as a footer.
+func (p TablePrinter) WithHasFooter(b ...bool) *TablePrinter {
+	p.HasFooter = internal.WithBoolean(b)
+	return &p
+}
+
+// WithFooterStyle returns a new TablePrinter with a specific FooterStyle.
+func (p TablePrinter) WithFooterStyle(style *Style) *TablePrinter {
+	p.FooterStyle = style
+	return &p
+}
+
+// WithFooterRowSeparator returns a new TablePrinter with a specific FooterRowSeparator.
+func (p TablePrinter) WithFooterRowSeparator(separator string) *TablePrinter {
+	p.FooterRowSeparator = separator
+	return &p
+}
+
+// WithFooterRowSeparatorStyle returns a new TablePrinter with a specific FooterRowSeparatorStyle.
+func (p TablePrinter) WithFooterRowSeparatorStyle(style *Style) *TablePrinter {
+	p.FooterRowSeparatorStyle = style
+	return &p
+}
+
 // WithSeparator returns a new TablePrinter with a specific separator.
 func (p TablePrinter) WithSeparator(separator string) *TablePrinter {
 	p.Separator = separator
@@ -98,12 +160,102 @@ func (p TablePrinter) WithRowSeparatorStyle(style *Style) *TablePrinter {
 	return &p
 }
 
+// WithAlternateRowStyle returns a new TablePrinter, where the body rows are alternately styled with the even and odd style.
+// The rows are counted from zero, starting at the first row after the header. The header and footer rows are not affected.
+// A nil style leaves the rows unstyled.
+func (p TablePrinter) WithAlternateRowStyle(even, odd *Style) *TablePrinter {
+	p.EvenRowStyle = even
+	p.OddRowStyle = odd
+	return &p
+}
+
+// WithBackgroundColor returns a new TablePrinter with a specific background color for all rows.
+// Black or white is used as text color, whichever is more readable on the background.
+func (p TablePrinter) WithBackgroundColor(rgb RGB) *TablePrinter {
+	p.BackgroundColor = &rgb
+	return &p
+}
+
+// WithRowSpans returns a new TablePrinter, where the given rows are printed as a single cell across the whole table width.
+// Only the first cell of such a row is printed, which is useful for group headers.
+func (p TablePrinter) WithRowSpans(rows map[int]bool) *TablePrinter {
+	p.RowSpans = rows
+	return &p
+}
+
+// WithRowSpanStyle returns a new TablePrinter with a specific RowSpanStyle.
+func (p TablePrinter) WithRowSpanStyle(style *Style) *TablePrinter {
+	p.RowSpanStyle = style
+	return &p
+}
+
+// WithRowSpanCentered returns a new TablePrinter, where the content of spanning rows is centered.
+func (p TablePrinter) WithRowSpanCentered(b ...bool) *TablePrinter {
+	p.RowSpanCentered = internal.WithBoolean(b)
+	return &p
+}
+
+// WithSortByColumn returns a new TablePrinter, which sorts the rows by the given column before rendering.
+// Cells, which are numbers, are compared by their value and sorted before all other cells.
+// Rows with equal cells keep their order. The header and footer are not sorted.
+// Rendering returns ErrColumnOutOfRange, if the column does not exist.
+func (p TablePrinter) WithSortByColumn(index int, desc bool) *TablePrinter {
+	p.SortColumn = &index
+	p.SortDescending = desc
+	return &p
+}
+
+// WithCellStyler returns a new TablePrinter, which styles the cells of the body with the returned Style of the styler.
+// The styler is called for every cell, which is not in the header or footer, with the index of the row and column in the Data.
+// If the styler returns nil, the cell is not styled.
+//
+// Example:
+//
+//	pterm.DefaultTable.WithCellStyler(func(row, col int, content string) *pterm.Style {
+//		if col == 1 && content == "FAIL" {
+//			return pterm.NewStyle(pterm.FgRed)
+//		}
+//		return nil
+//	})
+func (p TablePrinter) WithCellStyler(styler func(row, col int, content string) *Style) *TablePrinter {
+	p.CellStyler = styler
+	return &p
+}
+
 // WithData returns a new TablePrinter with specific Data.
 func (p TablePrinter) WithData(data [][]string) *TablePrinter {
 	p.Data = data
 	return &p
 }
 
+// WithLinkColumns returns a new TablePrinter, where the cells of the given columns are printed as clickable hyperlinks.
+// The cell text is used as the URL. Email addresses are linked with "mailto:".
+// The header and footer rows are not linked.
+func (p TablePrinter) WithLinkColumns(columns []int) *TablePrinter {
+	p.LinkColumns = columns
+	return &p
+}
+
+// WithMaxColumnWidth returns a new TablePrinter with a specific MaxColumnWidth.
+// The MaxColumnWidth is only applied, if a CellOverflow other than OverflowNone is set.
+func (p TablePrinter) WithMaxColumnWidth(width int) *TablePrinter {
+	p.MaxColumnWidth = width
+	return &p
+}
+
+// WithCellOverflow returns a new TablePrinter, which wraps or truncates cells wider than the MaxColumnWidth.
+func (p TablePrinter) WithCellOverflow(overflow TableCellOverflow) *TablePrinter {
+	p.CellOverflow = overflow
+	return &p
+}
+
+// WithVerticalCenter returns a new TablePrinter, where the cells of rows with multiple lines are centered vertically.
+// By default, the cells are aligned to the top of the row.
+func (p TablePrinter) WithVerticalCenter(b ...bool) *TablePrinter {
+	p.VerticalCenter = internal.WithBoolean(b)
+	return &p
+}
+
 // WithCSVReader return a new TablePrinter with specified Data extracted from CSV.
 func (p TablePrinter) WithCSVReader(reader *csv.Reader) *TablePrinter {
 	if records, err := reader.ReadAll(); err == nil {
@@ -142,6 +294,10 @@ func (p TablePrinter) WithWriter(writer io.Writer) *TablePrinter {
 
 // Srender renders the TablePrinter as a string.
 func (p TablePrinter) Srender() (string, error) {
+	p, err := p.sorted()
+	if err != nil {
+		return "", err
+	}
 	if p.Style == nil {
 		p.Style = NewStyle()
 	}
@@ -157,42 +313,139 @@ func (p TablePrinter) Srender() (string, error) {
 	if p.RowSeparatorStyle == nil {
 		p.RowSeparatorStyle = NewStyle()
 	}
+	if p.FooterStyle == nil {
+		p.FooterStyle = NewStyle()
+	}
+	if p.FooterRowSeparatorStyle == nil {
+		p.FooterRowSeparatorStyle = NewStyle()
+	}
+	if p.RowSpanStyle == nil {
+		p.RowSpanStyle = NewStyle()
+	}
+
+	// The lock is released before the table is boxed, because the BoxPrinter takes it itself.
+	themeLock.RLock()
+
+	// The footer is the last row, unless the only row is already the header.
+	footerIndex := -1
+	if p.HasFooter && (!p.HasHeader || len(p.Data) > 1) {
+		footerIndex = len(p.Data) - 1
+	}
 
 	var ret string
-	maxColumnWidth := make(map[int]int)
 
-	for _, row := range p.Data {
+	// every cell is split into the lines, which are printed
+	cells := make([][][]string, len(p.Data))
+	cellStyles := make([][]*Style, len(p.Data))
+	columnCount := 0
+	for ri, row := range p.Data {
+		if p.RowSpans[ri] {
+			continue
+		}
+		if len(row) > columnCount {
+			columnCount = len(row)
+		}
+		cells[ri] = make([][]string, len(row))
+		cellStyles[ri] = make([]*Style, len(row))
 		for ci, column := range row {
-			columnLength := runewidth.StringWidth(RemoveColorFromString(column))
-			if columnLength > maxColumnWidth[ci] {
-				maxColumnWidth[ci] = columnLength
+			cells[ri][ci] = p.cellLines(column)
+			if p.CellStyler != nil && ri != footerIndex && (!p.HasHeader || ri != 0) {
+				cellStyles[ri][ci] = p.CellStyler(ri, ci, column)
 			}
 		}
 	}
+	maxColumnWidth := columnWidths(cells, columnCount)
 
-	for ri, row := range p.Data {
-		rowWidth := 0
-		for ci, column := range row {
-			columnString := p.createColumnString(column, maxColumnWidth[ci])
-			rowWidth += runewidth.StringWidth(RemoveColorFromString(columnString))
+	// spanning rows fill the width of all columns and the separators between them
+	tableWidth := 0
+	for ci := 0; ci < columnCount; ci++ {
+		tableWidth += maxColumnWidth[ci]
+	}
+	if columnCount > 1 {
+		tableWidth += (columnCount - 1) * runewidth.StringWidth(RemoveColorFromString(p.SeparatorStyle.Sprint(p.Separator)))
+	}
+	if columnCount == 0 {
+		// there are only spanning rows, so the widest one defines the width
+		for _, row := range p.Data {
+			if len(row) > 0 && runewidth.StringWidth(RemoveColorFromString(row[0])) > tableWidth {
+				tableWidth = runewidth.StringWidth(RemoveColorFromString(row[0]))
+			}
+		}
+	}
 
-			if ci != len(row) && ci != 0 {
-				ret += p.Style.Sprint(p.SeparatorStyle.Sprint(p.Separator))
-				rowWidth += runewidth.StringWidth(RemoveColorFromString(p.SeparatorStyle.Sprint(p.Separator)))
+	for ri, row := range p.Data {
+		rowHeight := 1
+		for _, lines := range cells[ri] {
+			if len(lines) > rowHeight {
+				rowHeight = len(lines)
 			}
+		}
+		if p.RowSpans[ri] {
+			rowHeight = 0
+		}
 
-			if p.HasHeader && ri == 0 {
-				ret += p.Style.Sprint(p.HeaderStyle.Sprint(columnString))
-			} else {
-				ret += p.Style.Sprint(columnString)
+		var rowLines []string
+		rowWidth := 0
+		if p.RowSpans[ri] {
+			rowLines = append(rowLines, p.Style.Sprint(p.RowSpanStyle.Sprint(p.createRowSpanString(row, tableWidth))))
+			rowWidth = tableWidth
+		}
+		for li := 0; li < rowHeight; li++ {
+			var rowString string
+			rowWidth = 0
+			for ci, column := range row {
+				var line string
+				offset := 0
+				if p.VerticalCenter {
+					offset = (rowHeight - len(cells[ri][ci])) / 2
+				}
+				if li >= offset && li-offset < len(cells[ri][ci]) {
+					line = cells[ri][ci][li-offset]
+				}
+				if line != "" && ri != footerIndex && (!p.HasHeader || ri != 0) && p.isLinkColumn(ci) {
+					line = Hyperlink(line, cellURL(column))
+				}
+				if line != "" && cellStyles[ri][ci] != nil {
+					line = cellStyles[ri][ci].Sprint(line)
+				}
+				columnString := p.createColumnString(line, maxColumnWidth[ci])
+				rowWidth += runewidth.StringWidth(RemoveColorFromString(columnString))
+
+				if ci != len(row) && ci != 0 {
+					rowString += p.Style.Sprint(p.SeparatorStyle.Sprint(p.Separator))
+					rowWidth += runewidth.StringWidth(RemoveColorFromString(p.SeparatorStyle.Sprint(p.Separator)))
+				}
+
+				if p.HasHeader && ri == 0 {
+					rowString += p.Style.Sprint(p.HeaderStyle.Sprint(columnString))
+				} else if ri == footerIndex {
+					rowString += p.Style.Sprint(p.FooterStyle.Sprint(columnString))
+				} else {
+					rowString += p.Style.Sprint(columnString)
+				}
 			}
+			rowLines = append(rowLines, rowString)
+		}
+		rowString := strings.Join(rowLines, "\n")
+		if p.BackgroundColor != nil {
+			rowString = p.BackgroundColor.sprintOnBackground(rowString)
 		}
 
+		if rowStyle := p.alternateRowStyle(ri, footerIndex); rowStyle != nil {
+			rowString = rowStyle.Sprint(rowString)
+		}
+
+		if ri == footerIndex && p.FooterRowSeparator != "" {
+			ret += p.createFooterRowSeparatorString(rowWidth)
+		}
+
+		ret += rowString
+
 		if p.HasHeader && ri == 0 && p.HeaderRowSeparator != "" {
 			ret += p.createHeaderRowSeparatorString(rowWidth)
 		}
 
-		if ri != len(p.Data)-1 && ri != 0 && p.RowSeparator != "" {
+		if ri != len(p.Data)-1 && ri != 0 && ri+1 != footerIndex && p.RowSeparator != "" {
 			ret += p.createRowSeparatorString(rowWidth)
 		}
 
@@ -200,6 +453,7 @@ func (p TablePrinter) Srender() (string, error) {
 	}
 
 	ret = strings.TrimSuffix(ret, "\n")
+	themeLock.RUnlock()
 
 	if p.Boxed {
 		ret = DefaultBox.Sprint(ret)
@@ -208,6 +462,108 @@ func (p TablePrinter) Srender() (string, error) {
 	return ret, nil
 }
 
+// ColumnWidths returns the width of every column, as it is used by Render, without printing the table.
+// The widths include the header and the footer, but neither the separators nor spanning rows.
+// Double-width runes count as two columns, and color codes are ignored.
+func (p TablePrinter) ColumnWidths() ([]int, error) {
+	p, err := p.sorted()
+	if err != nil {
+		return nil, err
+	}
+
+	cells := make([][][]string, len(p.Data))
+	columnCount := 0
+	for ri, row := range p.Data {
+		if p.RowSpans[ri] {
+			continue
+		}
+		if len(row) > columnCount {
+			columnCount = len(row)
+		}
+		cells[ri] = make([][]string, len(row))
+		for ci, column := range row {
+			cells[ri][ci] = p.cellLines(column)
+		}
+	}
+	return columnWidths(cells, columnCount), nil
+}
+
+// columnWidths returns the width of the widest line in every column.
+func columnWidths(cells [][][]string, columnCount int) []int {
+	widths := make([]int, columnCount)
+	for _, row := range cells {
+		for ci, lines := range row {
+			for _, line := range lines {
+				if width := runewidth.StringWidth(RemoveColorFromString(line)); width > widths[ci] {
+					widths[ci] = width
+				}
+			}
+		}
+	}
+	return widths
+}
+
+// cellLines returns the lines of a cell, which are printed.
+// Cells are split at newlines, and lines, which are wider than the MaxColumnWidth, are wrapped or truncated, depending on the CellOverflow.
+func (p TablePrinter) cellLines(cell string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(cell, "\r\n", "\n"), "\n") {
+		lines = append(lines, p.overflowLines(line)...)
+	}
+	return lines
+}
+
+// overflowLines returns a single line of a cell, wrapped or truncated to the MaxColumnWidth.
+func (p TablePrinter) overflowLines(line string) []string {
+	if p.MaxColumnWidth <= 0 || runewidth.StringWidth(RemoveColorFromString(line)) <= p.MaxColumnWidth {
+		return []string{line}
+	}
+
+	switch p.CellOverflow {
+	case OverflowWrap:
+		return strings.Split(internal.WrapWords(line, p.MaxColumnWidth), "\n")
+	case OverflowTruncate:
+		return []string{internal.TruncateString(line, p.MaxColumnWidth, "…")}
+	}
+	return []string{line}
+}
+
+// alternateRowStyle returns the EvenRowStyle or OddRowStyle of a body row.
+// It returns nil for the header and footer rows.
+func (p TablePrinter) alternateRowStyle(rowIndex, footerIndex int) *Style {
+	if rowIndex == footerIndex {
+		return nil
+	}
+	if p.HasHeader {
+		if rowIndex == 0 {
+			return nil
+		}
+		rowIndex--
+	}
+	if rowIndex%2 == 0 {
+		return p.EvenRowStyle
+	}
+	return p.OddRowStyle
+}
+
+func (p TablePrinter) isLinkColumn(column int) bool {
+	for _, c := range p.LinkColumns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+// cellURL returns the URL of a cell in a link column.
+func cellURL(cell string) string {
+	url := strings.TrimSpace(RemoveColorFromString(cell))
+	if !strings.Contains(url, "://") && !strings.HasPrefix(url, "mailto:") && strings.Contains(url, "@") {
+		url = "mailto:" + url
+	}
+	return url
+}
+
 func (p TablePrinter) createColumnString(data string, maxColumnWidth int) string {
 	columnLength := runewidth.StringWidth(RemoveColorFromString(data))
 	if p.RightAlignment {
@@ -216,18 +572,273 @@ func (p TablePrinter) createColumnString(data string, maxColumnWidth int) string
 	return data + strings.Repeat(" ", maxColumnWidth-columnLength)
 }
 
+// createRowSpanString returns the first cell of a row, aligned within the width of the whole table.
+func (p TablePrinter) createRowSpanString(row []string, tableWidth int) string {
+	var data string
+	if len(row) > 0 {
+		data = internal.TruncateString(row[0], tableWidth, "…")
+	}
+	free := tableWidth - runewidth.StringWidth(RemoveColorFromString(data))
+	if free < 0 {
+		free = 0
+	}
+	if p.RowSpanCentered {
+		return strings.Repeat(" ", free/2) + data + strings.Repeat(" ", free-free/2)
+	}
+	if p.RightAlignment {
+		return strings.Repeat(" ", free) + data
+	}
+	return data + strings.Repeat(" ", free)
+}
+
 func (p TablePrinter) createHeaderRowSeparatorString(rowWidth int) string {
 	return "\n" + p.Style.Sprint(p.HeaderRowSeparatorStyle.Sprint(strings.Repeat(p.HeaderRowSeparator, rowWidth)))
 }
 
+func (p TablePrinter) createFooterRowSeparatorString(rowWidth int) string {
+	return p.Style.Sprint(p.FooterRowSeparatorStyle.Sprint(strings.Repeat(p.FooterRowSeparator, rowWidth))) + "\n"
+}
+
 func (p TablePrinter) createRowSeparatorString(rowWidth int) string {
 	return "\n" + p.Style.Sprint(p.RowSeparatorStyle.Sprint(strings.Repeat(p.RowSeparator, rowWidth)))
 }
 
 // Render prints the TablePrinter to the terminal.
 func (p TablePrinter) Render() error {
-	s, _ := p.Srender()
+	s, err := p.Srender()
+	if err != nil {
+		return err
+	}
 	Fprintln(p.Writer, s)
 
 	return nil
 }
+
+// RenderMarkdown renders the TablePrinter as a GitHub flavored Markdown table.
+// Colors are removed and "|" is escaped. Markdown tables always have a header,
+// so an empty header is added, if HasHeader is false.
+// Spanning rows are printed in the first column.
+func (p TablePrinter) RenderMarkdown() (string, error) {
+	p, err := p.sorted()
+	if err != nil {
+		return "", err
+	}
+	columnCount := p.columnCount()
+	if columnCount == 0 {
+		return "", nil
+	}
+
+	markdownRow := func(row []string) string {
+		cells := make([]string, columnCount)
+		for i := range cells {
+			if i < len(row) {
+				cells[i] = markdownEscaper.Replace(RemoveColorFromString(row[i]))
+			}
+		}
+		return "| " + strings.Join(cells, " | ") + " |"
+	}
+
+	alignment := ":---"
+	if p.RightAlignment {
+		alignment = "---:"
+	}
+	separator := "|" + strings.Repeat(" "+alignment+" |", columnCount)
+
+	var lines []string
+	for ri, row := range p.Data {
+		if ri == 0 {
+			if p.HasHeader {
+				lines = append(lines, markdownRow(row), separator)
+				continue
+			}
+			lines = append(lines, markdownRow(nil), separator)
+		}
+		if p.RowSpans[ri] && len(row) > 1 {
+			row = row[:1]
+		}
+		lines = append(lines, markdownRow(row))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// RenderHTML renders the TablePrinter as an HTML table.
+// Colors are removed and the content of the cells is escaped.
+// The header and footer are placed in <thead> and <tfoot>.
+func (p TablePrinter) RenderHTML() (string, error) {
+	p, err := p.sorted()
+	if err != nil {
+		return "", err
+	}
+	columnCount := p.columnCount()
+
+	style := ""
+	if p.RightAlignment {
+		style = ` style="text-align: right"`
+	}
+	htmlRow := func(row []string, tag string, span bool) string {
+		if span {
+			var cell string
+			if len(row) > 0 {
+				cell = htmlCell(row[0])
+			}
+			return fmt.Sprintf("    <tr><%s colspan=\"%d\"%s>%s</%s></tr>", tag, columnCount, style, cell, tag)
+		}
+		ret := "    <tr>"
+		for i := 0; i < columnCount; i++ {
+			var cell string
+			if i < len(row) {
+				cell = htmlCell(row[i])
+			}
+			ret += "<" + tag + style + ">" + cell + "</" + tag + ">"
+		}
+		return ret + "</tr>"
+	}
+
+	footerIndex := -1
+	if p.HasFooter && (!p.HasHeader || len(p.Data) > 1) {
+		footerIndex = len(p.Data) - 1
+	}
+
+	var head, body, foot []string
+	for ri, row := range p.Data {
+		switch {
+		case p.HasHeader && ri == 0:
+			head = append(head, htmlRow(row, "th", false))
+		case ri == footerIndex:
+			foot = append(foot, htmlRow(row, "td", false))
+		default:
+			body = append(body, htmlRow(row, "td", p.RowSpans[ri]))
+		}
+	}
+
+	lines := []string{"<table>"}
+	for _, section := range []struct {
+		tag  string
+		rows []string
+	}{{"thead", head}, {"tbody", body}, {"tfoot", foot}} {
+		if len(section.rows) == 0 {
+			continue
+		}
+		lines = append(lines, "  <"+section.tag+">")
+		lines = append(lines, section.rows...)
+		lines = append(lines, "  </"+section.tag+">")
+	}
+	lines = append(lines, "</table>")
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// markdownEscaper escapes the characters, which would break a Markdown table cell.
+var markdownEscaper = strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>")
+
+// htmlCell returns the escaped content of a cell without colors.
+func htmlCell(cell string) string {
+	return strings.ReplaceAll(html.EscapeString(RemoveColorFromString(cell)), "\n", "<br>")
+}
+
+// columnCount returns the number of columns of the widest row, which does not span the whole table.
+func (p TablePrinter) columnCount() int {
+	var count int
+	for ri, row := range p.Data {
+		if !p.RowSpans[ri] && len(row) > count {
+			count = len(row)
+		}
+	}
+	if count == 0 && len(p.Data) > 0 {
+		count = 1
+	}
+	return count
+}
+
+// sorted returns a copy of the TablePrinter, where the rows between the header and the footer are sorted by the SortColumn.
+// The RowSpans are updated to the new positions of their rows. Spanning rows are sorted like all other rows,
+// so the rows below a group header don't stay with it.
+func (p TablePrinter) sorted() (TablePrinter, error) {
+	if p.SortColumn == nil {
+		return p, nil
+	}
+	column := *p.SortColumn
+	if column < 0 || column >= p.columnCount() {
+		return p, fmt.Errorf("%w: %d", ErrColumnOutOfRange, column)
+	}
+
+	start, end := 0, len(p.Data)
+	if p.HasHeader {
+		start = 1
+	}
+	if p.HasFooter && (!p.HasHeader || len(p.Data) > 1) {
+		end--
+	}
+	if end-start < 2 {
+		return p, nil
+	}
+
+	order := make([]int, len(p.Data))
+	for i := range order {
+		order[i] = i
+	}
+	body := order[start:end]
+	sort.SliceStable(body, func(i, j int) bool {
+		c := compareCells(p.cell(body[i], column), p.cell(body[j], column))
+		if p.SortDescending {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	data := make(TableData, len(p.Data))
+	var rowSpans map[int]bool
+	if p.RowSpans != nil {
+		rowSpans = make(map[int]bool, len(p.RowSpans))
+	}
+	for i, ri := range order {
+		data[i] = p.Data[ri]
+		if p.RowSpans[ri] {
+			rowSpans[i] = true
+		}
+	}
+	p.Data = data
+	p.RowSpans = rowSpans
+
+	return p, nil
+}
+
+// cell returns the content of a cell without colors, or an empty string if the row is too short.
+func (p TablePrinter) cell(row, column int) string {
+	if column >= len(p.Data[row]) {
+		return ""
+	}
+	return strings.TrimSpace(RemoveColorFromString(p.Data[row][column]))
+}
+
+// compareCells compares two cells and returns -1, 0 or 1.
+// Numbers are compared by their value and are less than all other cells, which are compared as strings.
+func compareCells(a, b string) int {
+	numberA, isNumberA := parseCellNumber(a)
+	numberB, isNumberB := parseCellNumber(b)
+	switch {
+	case isNumberA && isNumberB:
+		switch {
+		case numberA < numberB:
+			return -1
+		case numberA > numberB:
+			return 1
+		}
+		return 0
+	case isNumberA:
+		return -1
+	case isNumberB:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// parseCellNumber returns the value of a cell, which contains a finite number.
+func parseCellNumber(cell string) (float64, bool) {
+	number, err := strconv.ParseFloat(cell, 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, false
+	}
+	return number, true
+}
@@ -2,8 +2,10 @@ package pterm_test
 
 import (
 	"encoding/csv"
+	"errors"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/MarvinJWendt/testza"
@@ -185,3 +187,470 @@ func TestTablePrinter_WithWriter(t *testing.T) {
 	testza.AssertEqual(t, s, p2.Writer)
 	testza.AssertZero(t, p.Writer)
 }
+
+func TestTablePrinter_WithHasFooter(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithHasFooter()
+
+	testza.AssertTrue(t, p2.HasFooter)
+}
+
+func TestTablePrinter_WithFooterStyle(t *testing.T) {
+	p := pterm.TablePrinter{}
+	s := pterm.NewStyle(pterm.FgRed, pterm.BgRed, pterm.Bold)
+	p2 := p.WithFooterStyle(s)
+
+	testza.AssertEqual(t, s, p2.FooterStyle)
+}
+
+func TestTablePrinter_WithFooterRowSeparator(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithFooterRowSeparator("=")
+
+	testza.AssertEqual(t, "=", p2.FooterRowSeparator)
+}
+
+func TestTablePrinter_WithFooterRowSeparatorStyle(t *testing.T) {
+	p := pterm.TablePrinter{}
+	s := pterm.NewStyle(pterm.FgRed, pterm.BgRed, pterm.Bold)
+	p2 := p.WithFooterRowSeparatorStyle(s)
+
+	testza.AssertEqual(t, s, p2.FooterRowSeparatorStyle)
+}
+
+func TestTablePrinter_SrenderWithHeaderAndFooter(t *testing.T) {
+	d := pterm.TableData{
+		{"Item", "Price"},
+		{"Apple", "1"},
+		{"Melon", "3"},
+		{"Total", "4"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithHasFooter().WithData(d).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Item  | Price\nApple | 1    \nMelon | 3    \n-------------\nTotal | 4    ", pterm.RemoveColorFromString(content))
+	lines := strings.Split(content, "\n")
+	testza.AssertContains(t, lines[len(lines)-1], "\x1b["+pterm.FgLightYellow.String()+"m")
+	testza.AssertNotContains(t, lines[1], "\x1b["+pterm.FgLightYellow.String()+"m")
+}
+
+func TestTablePrinter_SrenderWithHeaderAndFooterWithoutBody(t *testing.T) {
+	d := pterm.TableData{
+		{"Item", "Price"},
+		{"Total", "0"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithHasFooter().WithData(d).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Item  | Price\n-------------\nTotal | 0    ", pterm.RemoveColorFromString(content))
+}
+
+func TestTablePrinter_WithLinkColumns(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithLinkColumns([]int{1})
+
+	testza.AssertEqual(t, []int{1}, p2.LinkColumns)
+}
+
+func TestTablePrinter_SrenderWithLinkColumns(t *testing.T) {
+	pterm.EnableHyperlinks()
+	defer pterm.DisableHyperlinks()
+
+	d := pterm.TableData{
+		{"Name", "Contact"},
+		{"Paul", "paul@example.com"},
+		{"Website", "https://pterm.sh"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithLinkColumns([]int{1}).WithData(d).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertContains(t, content, "\x1b]8;;mailto:paul@example.com\x1b\\")
+	testza.AssertContains(t, content, "\x1b]8;;https://pterm.sh\x1b\\")
+	testza.AssertNotContains(t, content, "\x1b]8;;Contact")
+	testza.AssertEqual(t, "Name    | Contact         \nPaul    | paul@example.com\nWebsite | https://pterm.sh", pterm.RemoveColorFromString(content))
+}
+
+func TestTablePrinter_WithAlternateRowStyle(t *testing.T) {
+	p := pterm.TablePrinter{}
+	even := pterm.NewStyle(pterm.BgGray)
+	odd := pterm.NewStyle(pterm.BgBlack)
+	p2 := p.WithAlternateRowStyle(even, odd)
+
+	testza.AssertEqual(t, even, p2.EvenRowStyle)
+	testza.AssertEqual(t, odd, p2.OddRowStyle)
+}
+
+func TestTablePrinter_SrenderWithAlternateRowStyle(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Language"},
+		{"Paul", "Go"},
+		{"Dong", "中文"},
+		{"Anna", "Rust"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithAlternateRowStyle(pterm.NewStyle(pterm.BgBlue), nil).WithData(d).Srender()
+	testza.AssertNoError(t, err)
+
+	lines := strings.Split(content, "\n")
+	testza.AssertNotContains(t, lines[0], "\x1b[44m")
+	testza.AssertContains(t, lines[1], "\x1b[44m")
+	testza.AssertNotContains(t, lines[2], "\x1b[44m")
+	testza.AssertContains(t, lines[3], "\x1b[44m")
+
+	plain, _ := pterm.DefaultTable.WithHasHeader().WithData(d).Srender()
+	testza.AssertEqual(t, pterm.RemoveColorFromString(plain), pterm.RemoveColorFromString(content))
+}
+
+func TestTablePrinter_WithBackgroundColor(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithBackgroundColor(pterm.NewRGB(0, 0, 128))
+
+	testza.AssertEqual(t, pterm.NewRGB(0, 0, 128), *p2.BackgroundColor)
+	testza.AssertNil(t, p.BackgroundColor)
+}
+
+func TestTablePrinter_SrenderWithBackgroundColor(t *testing.T) {
+	d := pterm.TableData{{"Name", "Language"}, {"Paul", "Go"}}
+	content, err := pterm.DefaultTable.WithHasHeader().WithBackgroundColor(pterm.NewRGB(0, 0, 128)).WithData(d).Srender()
+	testza.AssertNoError(t, err)
+
+	for _, line := range strings.Split(content, "\n") {
+		testza.AssertContains(t, line, "38;2;255;255;255;48;2;0;0;128")
+	}
+
+	plain, _ := pterm.DefaultTable.WithHasHeader().WithData(d).Srender()
+	testza.AssertEqual(t, pterm.RemoveColorFromString(plain), pterm.RemoveColorFromString(content))
+}
+
+func TestTablePrinter_WithMaxColumnWidth(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithMaxColumnWidth(10)
+
+	testza.AssertEqual(t, 10, p2.MaxColumnWidth)
+	testza.AssertZero(t, p.MaxColumnWidth)
+}
+
+func TestTablePrinter_WithCellOverflow(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithCellOverflow(pterm.OverflowWrap)
+
+	testza.AssertEqual(t, pterm.OverflowWrap, p2.CellOverflow)
+	testza.AssertEqual(t, pterm.OverflowNone, p.CellOverflow)
+}
+
+func TestTablePrinter_SrenderCellOverflow(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Description"},
+		{"pterm", "Beautify console output"},
+		{"中文", "中文中文中文"},
+	}
+	tests := []struct {
+		name     string
+		overflow pterm.TableCellOverflow
+		expected string
+	}{
+		{
+			name:     "None",
+			overflow: pterm.OverflowNone,
+			expected: "Name  | Description            \npterm | Beautify console output\n中文  | 中文中文中文           ",
+		},
+		{
+			name:     "Truncate",
+			overflow: pterm.OverflowTruncate,
+			expected: "Name  | Description\npterm | Beautify c…\n中文  | 中文中文中…",
+		},
+		{
+			name:     "Wrap",
+			overflow: pterm.OverflowWrap,
+			expected: "Name  | Description\npterm | Beautify   \n      | console    \n      | output     \n中文  | 中文中文中 \n      | 文         ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := pterm.DefaultTable.WithData(d).WithMaxColumnWidth(11).WithCellOverflow(tt.overflow).Srender()
+			testza.AssertNoError(t, err)
+			testza.AssertEqual(t, tt.expected, pterm.RemoveColorFromString(content))
+		})
+	}
+}
+
+func TestTablePrinter_WithRowSpans(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithRowSpans(map[int]bool{1: true})
+
+	testza.AssertEqual(t, map[int]bool{1: true}, p2.RowSpans)
+	testza.AssertNil(t, p.RowSpans)
+}
+
+func TestTablePrinter_WithRowSpanStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.TablePrinter{}
+	p2 := p.WithRowSpanStyle(s)
+
+	testza.AssertEqual(t, s, p2.RowSpanStyle)
+	testza.AssertNil(t, p.RowSpanStyle)
+}
+
+func TestTablePrinter_WithRowSpanCentered(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithRowSpanCentered()
+
+	testza.AssertTrue(t, p2.RowSpanCentered)
+	testza.AssertFalse(t, p.RowSpanCentered)
+}
+
+func TestTablePrinter_SrenderWithRowSpans(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Region"},
+		{"Cluster A with a very long name"},
+		{"node-1", "eu"},
+		{"Cluster B"},
+		{"node-2", "us"},
+	}
+	spans := map[int]bool{1: true, 3: true}
+
+	t.Run("Left", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithHasHeader().WithRowSpans(spans).WithData(d).Srender()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, "Name   | Region\nCluster A with…\nnode-1 | eu    \nCluster B      \nnode-2 | us    ", pterm.RemoveColorFromString(content))
+	})
+
+	t.Run("Centered", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithHasHeader().WithRowSpans(spans).WithRowSpanCentered().WithData(d).Srender()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, "   Cluster B   ", strings.Split(pterm.RemoveColorFromString(content), "\n")[3])
+	})
+
+	t.Run("OnlySpans", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithRowSpans(map[int]bool{0: true, 1: true}).WithData(pterm.TableData{{"A"}, {"Group"}}).Srender()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, "A    \nGroup", pterm.RemoveColorFromString(content))
+	})
+}
+
+func TestTablePrinter_RenderMarkdown(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Note"},
+		{pterm.Red("Paul"), "a|b"},
+		{"Anna", "line\nbreak"},
+	}
+
+	t.Run("WithHeader", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithHasHeader().WithData(d).RenderMarkdown()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, "| Name | Note |\n| :--- | :--- |\n| Paul | a\\|b |\n| Anna | line<br>break |", content)
+	})
+
+	t.Run("WithoutHeader", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithRightAlignment().WithData(d[:2]).RenderMarkdown()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, "|  |  |\n| ---: | ---: |\n| Name | Note |\n| Paul | a\\|b |", content)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		content, err := pterm.DefaultTable.RenderMarkdown()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, "", content)
+	})
+}
+
+func TestTablePrinter_RenderHTML(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Note"},
+		{"Group"},
+		{pterm.Red("Paul"), "<b>&</b>"},
+		{"Total", "1"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithHasFooter().WithRowSpans(map[int]bool{1: true}).WithData(d).RenderHTML()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, `<table>
+  <thead>
+    <tr><th>Name</th><th>Note</th></tr>
+  </thead>
+  <tbody>
+    <tr><td colspan="2">Group</td></tr>
+    <tr><td>Paul</td><td>&lt;b&gt;&amp;&lt;/b&gt;</td></tr>
+  </tbody>
+  <tfoot>
+    <tr><td>Total</td><td>1</td></tr>
+  </tfoot>
+</table>`, content)
+}
+
+func TestTablePrinter_WithSortByColumn(t *testing.T) {
+	p := pterm.DefaultTable.WithSortByColumn(1, true)
+	testza.AssertEqual(t, 1, *p.SortColumn)
+	testza.AssertTrue(t, p.SortDescending)
+	testza.AssertNil(t, pterm.DefaultTable.SortColumn)
+}
+
+func TestTablePrinter_SrenderWithSortByColumn(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Size"},
+		{"b", "10"},
+		{"a", "9"},
+		{"c", pterm.Red("100")},
+		{"d", "n/a"},
+		{"e", "9"},
+		{"Total", "128"},
+	}
+	column := func(content string, ci int) []string {
+		var ret []string
+		for _, line := range strings.Split(pterm.RemoveColorFromString(content), "\n") {
+			ret = append(ret, strings.TrimSpace(strings.Split(line, "|")[ci]))
+		}
+		return ret
+	}
+
+	t.Run("Ascending", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithHasHeader().WithHasFooter().WithFooterRowSeparator("").WithSortByColumn(1, false).WithData(d).Srender()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, []string{"Name", "a", "e", "b", "c", "d", "Total"}, column(content, 0))
+	})
+
+	t.Run("Descending", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithHasHeader().WithHasFooter().WithFooterRowSeparator("").WithSortByColumn(1, true).WithData(d).Srender()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, []string{"Name", "d", "c", "b", "a", "e", "Total"}, column(content, 0))
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithSortByColumn(0, false).WithData(d).Srender()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, []string{"Name", "Total", "a", "b", "c", "d", "e"}, column(content, 0))
+	})
+
+	t.Run("DataIsNotModified", func(t *testing.T) {
+		_, _ = pterm.DefaultTable.WithSortByColumn(0, false).WithData(d).Srender()
+		testza.AssertEqual(t, "b", d[1][0])
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		content, err := pterm.DefaultTable.WithHasHeader().WithSortByColumn(0, true).WithData(d[:3]).RenderMarkdown()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, "| Name | Size |\n| :--- | :--- |\n| b | 10 |\n| a | 9 |", content)
+	})
+}
+
+func TestTablePrinter_SortByColumnOutOfRange(t *testing.T) {
+	for _, index := range []int{-1, 2} {
+		p := pterm.DefaultTable.WithData(pterm.TableData{{"a", "b"}}).WithSortByColumn(index, false)
+
+		_, err := p.Srender()
+		testza.AssertTrue(t, errors.Is(err, pterm.ErrColumnOutOfRange))
+		testza.AssertTrue(t, errors.Is(p.Render(), pterm.ErrColumnOutOfRange))
+		_, err = p.RenderMarkdown()
+		testza.AssertTrue(t, errors.Is(err, pterm.ErrColumnOutOfRange))
+		_, err = p.RenderHTML()
+		testza.AssertTrue(t, errors.Is(err, pterm.ErrColumnOutOfRange))
+	}
+}
+
+func TestTablePrinter_WithCellStyler(t *testing.T) {
+	p := pterm.DefaultTable.WithCellStyler(func(row, col int, content string) *pterm.Style { return nil })
+	testza.AssertNotNil(t, p.CellStyler)
+	testza.AssertNil(t, pterm.DefaultTable.CellStyler)
+}
+
+func TestTablePrinter_SrenderWithCellStyler(t *testing.T) {
+	d := pterm.TableData{
+		{"Service", "Status"},
+		{"api", "OK"},
+		{"database", "FAIL"},
+		{"Total", "FAIL"},
+	}
+	red := pterm.NewStyle(pterm.FgRed)
+	var calls [][2]int
+	styler := func(row, col int, content string) *pterm.Style {
+		calls = append(calls, [2]int{row, col})
+		if content == "FAIL" {
+			return red
+		}
+		return nil
+	}
+
+	plain, err := pterm.DefaultTable.WithHasHeader().WithHasFooter().WithData(d).Srender()
+	testza.AssertNoError(t, err)
+	styled, err := pterm.DefaultTable.WithHasHeader().WithHasFooter().WithCellStyler(styler).WithData(d).Srender()
+	testza.AssertNoError(t, err)
+
+	// the styler is only called for the body
+	testza.AssertEqual(t, [][2]int{{1, 0}, {1, 1}, {2, 0}, {2, 1}}, calls)
+	testza.AssertEqual(t, pterm.RemoveColorFromString(plain), pterm.RemoveColorFromString(styled))
+	testza.AssertEqual(t, 1, strings.Count(styled, "\x1b[31m\x1b[31mFAIL"))
+}
+
+func TestTablePrinter_WithVerticalCenter(t *testing.T) {
+	p := pterm.DefaultTable.WithVerticalCenter()
+
+	testza.AssertTrue(t, p.VerticalCenter)
+	testza.AssertFalse(t, pterm.DefaultTable.VerticalCenter)
+}
+
+func TestTablePrinter_SrenderMultiLineCells(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Notes"},
+		{"pterm", "first line\nsecond\nthird"},
+		{"go", "single"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithData(d).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, ""+
+		"Name  | Notes     \n"+
+		"pterm | first line\n"+
+		"      | second    \n"+
+		"      | third     \n"+
+		"go    | single    ", pterm.RemoveColorFromString(content))
+}
+
+func TestTablePrinter_SrenderMultiLineCellsCenteredVertically(t *testing.T) {
+	d := pterm.TableData{
+		{"a\nb\nc\nd", "x", "y\nz"},
+	}
+	content, err := pterm.DefaultTable.WithData(d).WithVerticalCenter().Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, ""+
+		"a |   |  \n"+
+		"b | x | y\n"+
+		"c |   | z\n"+
+		"d |   |  ", pterm.RemoveColorFromString(content))
+}
+
+func TestTablePrinter_SrenderMultiLineCellsWithOverflow(t *testing.T) {
+	d := pterm.TableData{
+		{"id", "long text here\nshort"},
+	}
+	content, err := pterm.DefaultTable.WithData(d).WithMaxColumnWidth(9).WithCellOverflow(pterm.OverflowWrap).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, ""+
+		"id | long text\n"+
+		"   | here     \n"+
+		"   | short    ", pterm.RemoveColorFromString(content))
+}
+
+func TestTablePrinter_ColumnWidths(t *testing.T) {
+	data := pterm.TableData{
+		{"Name", "Description"},
+		{"你好", pterm.Red("red")},
+		{"a", "multi\nline text"},
+	}
+	widths, err := pterm.DefaultTable.WithHasHeader().WithData(data).ColumnWidths()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, []int{4, 11}, widths)
+
+	// wrapped cells are measured like they are rendered
+	widths, err = pterm.DefaultTable.WithData(data).WithMaxColumnWidth(6).WithCellOverflow(pterm.OverflowWrap).ColumnWidths()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, []int{4, 6}, widths)
+}
+
+func TestTablePrinter_ColumnWidthsMatchRender(t *testing.T) {
+	table := pterm.DefaultTable.WithSeparator(" | ").WithData(pterm.TableData{{"a", "bbb"}, {"cc", "d"}})
+	widths, err := table.ColumnWidths()
+	testza.AssertNoError(t, err)
+
+	out, _ := table.Srender()
+	firstLine := strings.Split(pterm.RemoveColorFromString(out), "\n")[0]
+	testza.AssertEqual(t, widths[0]+len(" | ")+widths[1], len(firstLine))
+}
+
+func TestTablePrinter_ColumnWidthsInvalidSortColumn(t *testing.T) {
+	_, err := pterm.DefaultTable.WithData(pterm.TableData{{"a"}}).WithSortByColumn(3, false).ColumnWidths()
+	testza.AssertErrorIs(t, err, pterm.ErrColumnOutOfRange)
+}
@@ -0,0 +1,46 @@
+package pterm
+
+import (
+	"io"
+	"sync"
+)
+
+// TeeWriter duplicates every write to all of its writers.
+// It can be used together with SetDefaultOutput to capture the output of pterm, while still printing it to the terminal.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	pterm.SetDefaultOutput(pterm.NewTeeWriter(os.Stdout, &buf))
+type TeeWriter struct {
+	Writers []io.Writer
+
+	mu sync.Mutex
+}
+
+// NewTeeWriter returns a new TeeWriter, which writes to every given writer.
+func NewTeeWriter(w ...io.Writer) *TeeWriter {
+	return &TeeWriter{Writers: w}
+}
+
+// Write writes p to every writer of the TeeWriter.
+// A failing writer does not stop the write to the remaining writers, the first error is returned.
+func (t *TeeWriter) Write(p []byte) (n int, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, w := range t.Writers {
+		written, writeErr := w.Write(p)
+		if writeErr == nil && written != len(p) {
+			writeErr = io.ErrShortWrite
+		}
+		if writeErr != nil && err == nil {
+			err = writeErr
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,51 @@
+package pterm_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestTeeWriter_Write(t *testing.T) {
+	var a, b bytes.Buffer
+	n, err := pterm.NewTeeWriter(&a, &b).Write([]byte("Hello, World!"))
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 13, n)
+	testza.AssertEqual(t, "Hello, World!", a.String())
+	testza.AssertEqual(t, "Hello, World!", b.String())
+}
+
+func TestTeeWriter_WriteContinuesAfterError(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := pterm.NewTeeWriter(failingWriter{}, &buf).Write([]byte("Hello, World!"))
+	testza.AssertNotNil(t, err)
+	testza.AssertEqual(t, "Hello, World!", buf.String())
+}
+
+func TestTeeWriter_CapturesDefaultOutput(t *testing.T) {
+	var buf bytes.Buffer
+	pterm.SetDefaultOutput(pterm.NewTeeWriter(&outBuf, &buf))
+	defer setupStdoutCapture()
+
+	pterm.DefaultTable.WithData(pterm.TableData{{"table-cell"}}).Render()
+	bar, _ := pterm.DefaultProgressbar.WithTotal(2).WithTitle("progressbar-title").Start()
+	bar.Increment()
+	bar.Stop()
+	spinner, _ := pterm.DefaultSpinner.Start()
+	spinner.Success("spinner-success")
+
+	testza.AssertContains(t, buf.String(), "table-cell")
+	testza.AssertContains(t, buf.String(), "progressbar-title")
+	testza.AssertContains(t, buf.String(), "spinner-success")
+	testza.AssertContains(t, outBuf.String(), "table-cell")
+}
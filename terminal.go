@@ -2,6 +2,8 @@ package pterm
 
 import (
 	"os"
+	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 	"golang.org/x/term"
@@ -21,6 +23,28 @@ var forcedTerminalWidth *atomic.Int64 = atomic.NewInt64(0)
 // forcedTerminalHeight, when set along with forcedTerminalWidth, forces the terminal height value.
 var forcedTerminalHeight *atomic.Int64 = atomic.NewInt64(0)
 
+// terminalSizeCacheTTL is the duration, after which a cached terminal size is queried again.
+// On platforms that support SIGWINCH, the cache is additionally invalidated when the terminal is resized.
+var terminalSizeCacheTTL = 250 * time.Millisecond
+
+// terminalSizeCache caches the detected terminal size, so that live printers don't need a syscall per frame.
+var terminalSizeCache struct {
+	sync.Mutex
+	width     int
+	height    int
+	err       error
+	updatedAt time.Time
+}
+
+var watchTerminalResizeOnce sync.Once
+
+// invalidateTerminalSizeCache forces the next call of GetTerminalSize to query the terminal size again.
+func invalidateTerminalSizeCache() {
+	terminalSizeCache.Lock()
+	defer terminalSizeCache.Unlock()
+	terminalSizeCache.updatedAt = time.Time{}
+}
+
 // GetTerminalWidth returns the terminal width of the active terminal.
 func GetTerminalWidth() int {
 	if forcedTerminalWidth.Load() > 0 {
@@ -40,10 +64,30 @@ func GetTerminalHeight() int {
 }
 
 // GetTerminalSize returns the width and the height of the active terminal.
+// The size is cached for a short time and refreshed when the terminal is resized.
 func GetTerminalSize() (width, height int, err error) {
 	if forcedTerminalWidth.Load() > 0 && forcedTerminalHeight.Load() > 0 {
 		return int(forcedTerminalWidth.Load()), int(forcedTerminalHeight.Load()), nil
 	}
+	watchTerminalResizeOnce.Do(watchTerminalResize)
+
+	terminalSizeCache.Lock()
+	defer terminalSizeCache.Unlock()
+	if !terminalSizeCache.updatedAt.IsZero() && time.Since(terminalSizeCache.updatedAt) < terminalSizeCacheTTL {
+		return terminalSizeCache.width, terminalSizeCache.height, terminalSizeCache.err
+	}
+
+	width, height, err = detectTerminalSize()
+	terminalSizeCache.width = width
+	terminalSizeCache.height = height
+	terminalSizeCache.err = err
+	terminalSizeCache.updatedAt = time.Now()
+
+	return width, height, err
+}
+
+// detectTerminalSize queries the size of the active terminal.
+func detectTerminalSize() (width, height int, err error) {
 	w, h, err := term.GetSize(int(os.Stdout.Fd()))
 	if w <= 0 {
 		w = FallbackTerminalWidth
@@ -61,5 +105,6 @@ func GetTerminalSize() (width, height int, err error) {
 func SetForcedTerminalSize(width int, height int) {
 	forcedTerminalWidth.Store(int64(width))
 	forcedTerminalHeight.Store(int64(height))
+	invalidateTerminalSizeCache()
 	RecalculateTerminalSize()
 }
@@ -0,0 +1,77 @@
+package pterm
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"atomicgo.dev/cursor"
+
+	"github.com/pterm/pterm/internal"
+)
+
+// terminalArea is a part of the terminal, which can be redrawn and cleared.
+// Unlike cursor.Area, it remembers the rows it printed and the terminal width it was drawn with, so that lines,
+// which are wrapped by the terminal, are cleared as well, even if the terminal was resized in between.
+type terminalArea struct {
+	content string
+	width   int
+	rows    int
+	drawn   bool
+}
+
+// update clears the area and draws the content into it.
+func (a *terminalArea) update(content string) {
+	a.clear()
+
+	width := GetTerminalWidth()
+	rows := terminalRows(content, width)
+	fmt.Println(strings.Repeat("\n", rows-1)) // This appends space if the terminal is at the bottom
+	cursor.Up(rows)
+
+	lines := strings.Split(content, "\n")
+	if runtime.GOOS == "windows" {
+		for _, line := range lines {
+			fmt.Print(line)
+			cursor.StartOfLineDown(1)
+		}
+	} else {
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+	}
+
+	a.content = content
+	a.width = width
+	a.rows = rows
+	a.drawn = true
+}
+
+// clear clears every row of the area and moves the cursor to the start of the area.
+func (a *terminalArea) clear() {
+	if !a.drawn {
+		return
+	}
+	rows := a.rows
+	if width := GetTerminalWidth(); width != a.width {
+		// the terminal reflows the printed lines to the new width
+		rows = terminalRows(a.content, width)
+	}
+	cursor.ClearLinesUp(rows)
+	a.drawn = false
+}
+
+// terminalRows returns the number of rows, which the content takes up in a terminal with the given width.
+func terminalRows(content string, width int) int {
+	if width < 1 {
+		width = 1
+	}
+	var rows int
+	for _, line := range strings.Split(content, "\n") {
+		rows++
+		if w := internal.DisplayWidth(line); w > width {
+			rows += (w - 1) / width
+		}
+	}
+	return rows
+}
@@ -0,0 +1,8 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+
+package pterm
+
+// watchTerminalResize does nothing on platforms without SIGWINCH.
+// The cached terminal size is refreshed after terminalSizeCacheTTL instead,
+// and AreaPrinters pick up the new size with their next update.
+func watchTerminalResize() {}
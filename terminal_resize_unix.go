@@ -0,0 +1,22 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+package pterm
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// watchTerminalResize invalidates the cached terminal size whenever the terminal is resized,
+// and redraws the active AreaPrinters, so that their layout fits the new size.
+func watchTerminalResize() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGWINCH)
+	go func() {
+		for range c {
+			invalidateTerminalSizeCache()
+			redrawAreaPrinters()
+		}
+	}()
+}
@@ -0,0 +1,38 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+package pterm_test
+
+import (
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestAreaPrinter_RedrawnOnResize(t *testing.T) {
+	defer pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+	// the resize signal is only watched, if the terminal size is not forced
+	pterm.SetForcedTerminalSize(0, 0)
+
+	content := captureArea(t, func() {
+		area, _ := pterm.DefaultArea.Start("content")
+		testza.AssertNoError(t, syscall.Kill(os.Getpid(), syscall.SIGWINCH))
+
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			out, _ := os.ReadFile(os.Stdout.Name())
+			if strings.Count(string(out), "content") == 2 {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		area.Stop()
+	})
+
+	testza.AssertEqual(t, 2, strings.Count(content, "content"))
+}
@@ -52,3 +52,14 @@ func TestGetTerminalSizeAutodetect2(t *testing.T) {
 	// disable autodetection
 	pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
 }
+
+func TestRecalculateTerminalSizeInvalidatesCache(t *testing.T) {
+	pterm.SetForcedTerminalSize(0, 0)
+	defer pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+	expectedW, expectedH := autodetectTerminalSize()
+	pterm.GetTerminalSize()
+	pterm.RecalculateTerminalSize()
+	w, h, _ := pterm.GetTerminalSize()
+	testza.AssertEqual(t, expectedW, w)
+	testza.AssertEqual(t, expectedH, h)
+}
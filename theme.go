@@ -1,5 +1,12 @@
 package pterm
 
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"sync"
+)
+
 var (
 	// ThemeDefault is the default theme used by PTerm.
 	// If this variable is overwritten, the new value is used as default theme.
@@ -29,12 +36,15 @@ var (
 		SpinnerTextStyle:        Style{FgLightWhite},
 		TableStyle:              Style{FgDefault},
 		TableHeaderStyle:        Style{FgLightCyan},
+		TableFooterStyle:        Style{FgLightYellow},
 		TableSeparatorStyle:     Style{FgGray},
+		TableRowSpanStyle:       Style{Bold, FgLightMagenta},
 		SectionStyle:            Style{Bold, FgYellow},
 		BulletListTextStyle:     Style{FgDefault},
 		BulletListBulletStyle:   Style{FgGray},
 		TreeStyle:               Style{FgGray},
 		TreeTextStyle:           Style{FgDefault},
+		TreeAnnotationStyle:     Style{FgGray},
 		LetterStyle:             Style{FgDefault},
 		DebugMessageStyle:       Style{FgGray},
 		DebugPrefixStyle:        Style{FgBlack, BgGray},
@@ -43,11 +53,117 @@ var (
 		BarLabelStyle:           Style{FgLightCyan},
 		BarStyle:                Style{FgCyan},
 		TimerStyle:              Style{FgGray},
+		TimestampStyle:          Style{FgGray},
 		Checkmark: Checkmark{
 			Checked:   Green("✓"),
 			Unchecked: Red("✗"),
 		},
 	}
+
+	// ThemeHighContrast is a theme with bright colors and bold text, which is easier to read.
+	// Use pterm.SetTheme(pterm.ThemeHighContrast) to use it.
+	ThemeHighContrast = Theme{
+		DefaultText:             Style{FgDefault, BgDefault},
+		PrimaryStyle:            Style{Bold, FgLightCyan},
+		SecondaryStyle:          Style{Bold, FgLightMagenta},
+		HighlightStyle:          Style{Bold, FgLightYellow},
+		InfoMessageStyle:        Style{FgLightCyan},
+		InfoPrefixStyle:         Style{Bold, FgBlack, BgLightCyan},
+		SuccessMessageStyle:     Style{FgLightGreen},
+		SuccessPrefixStyle:      Style{Bold, FgBlack, BgLightGreen},
+		WarningMessageStyle:     Style{FgLightYellow},
+		WarningPrefixStyle:      Style{Bold, FgBlack, BgLightYellow},
+		ErrorMessageStyle:       Style{Bold, FgLightRed},
+		ErrorPrefixStyle:        Style{Bold, FgBlack, BgLightRed},
+		FatalMessageStyle:       Style{Bold, FgLightRed},
+		FatalPrefixStyle:        Style{Bold, FgBlack, BgLightRed},
+		DescriptionMessageStyle: Style{FgLightWhite},
+		DescriptionPrefixStyle:  Style{Bold, FgBlack, BgLightWhite},
+		ScopeStyle:              Style{FgLightWhite},
+		ProgressbarBarStyle:     Style{FgLightCyan},
+		ProgressbarTitleStyle:   Style{Bold, FgLightCyan},
+		HeaderTextStyle:         Style{Bold, FgBlack},
+		HeaderBackgroundStyle:   Style{BgLightWhite},
+		SpinnerStyle:            Style{Bold, FgLightCyan},
+		SpinnerTextStyle:        Style{FgLightWhite},
+		TableStyle:              Style{FgDefault},
+		TableHeaderStyle:        Style{Bold, FgLightCyan},
+		TableFooterStyle:        Style{Bold, FgLightYellow},
+		TableSeparatorStyle:     Style{FgLightWhite},
+		TableRowSpanStyle:       Style{Bold, FgLightMagenta},
+		SectionStyle:            Style{Bold, FgLightYellow},
+		BulletListTextStyle:     Style{FgDefault},
+		BulletListBulletStyle:   Style{Bold, FgLightWhite},
+		TreeStyle:               Style{FgLightWhite},
+		TreeTextStyle:           Style{FgDefault},
+		TreeAnnotationStyle:     Style{FgLightWhite},
+		LetterStyle:             Style{FgDefault},
+		DebugMessageStyle:       Style{FgLightWhite},
+		DebugPrefixStyle:        Style{Bold, FgBlack, BgLightWhite},
+		BoxStyle:                Style{FgLightWhite},
+		BoxTextStyle:            Style{FgDefault},
+		BarLabelStyle:           Style{Bold, FgLightCyan},
+		BarStyle:                Style{FgLightCyan},
+		TimerStyle:              Style{FgLightWhite},
+		TimestampStyle:          Style{FgLightWhite},
+		Checkmark: Checkmark{
+			Checked:   LightGreen("✓"),
+			Unchecked: LightRed("✗"),
+		},
+	}
+
+	// ThemeMonochrome is a theme without colors. It only uses bold, underlined and reversed text.
+	// This is useful for screen readers and displays, which can't show colors.
+	// Use pterm.SetTheme(pterm.ThemeMonochrome) to use it.
+	ThemeMonochrome = Theme{
+		DefaultText:             Style{},
+		PrimaryStyle:            Style{Bold},
+		SecondaryStyle:          Style{Underscore},
+		HighlightStyle:          Style{Bold, Underscore},
+		InfoMessageStyle:        Style{},
+		InfoPrefixStyle:         Style{Reverse},
+		SuccessMessageStyle:     Style{},
+		SuccessPrefixStyle:      Style{Reverse},
+		WarningMessageStyle:     Style{Bold},
+		WarningPrefixStyle:      Style{Bold, Reverse},
+		ErrorMessageStyle:       Style{Bold},
+		ErrorPrefixStyle:        Style{Bold, Reverse},
+		FatalMessageStyle:       Style{Bold},
+		FatalPrefixStyle:        Style{Bold, Reverse},
+		DescriptionMessageStyle: Style{},
+		DescriptionPrefixStyle:  Style{Reverse},
+		ScopeStyle:              Style{Underscore},
+		ProgressbarBarStyle:     Style{},
+		ProgressbarTitleStyle:   Style{Bold},
+		HeaderTextStyle:         Style{Bold},
+		HeaderBackgroundStyle:   Style{Reverse},
+		SpinnerStyle:            Style{Bold},
+		SpinnerTextStyle:        Style{},
+		TableStyle:              Style{},
+		TableHeaderStyle:        Style{Bold},
+		TableFooterStyle:        Style{Bold},
+		TableSeparatorStyle:     Style{},
+		TableRowSpanStyle:       Style{Bold},
+		SectionStyle:            Style{Bold, Underscore},
+		BulletListTextStyle:     Style{},
+		BulletListBulletStyle:   Style{Bold},
+		TreeStyle:               Style{},
+		TreeTextStyle:           Style{},
+		TreeAnnotationStyle:     Style{},
+		LetterStyle:             Style{},
+		DebugMessageStyle:       Style{},
+		DebugPrefixStyle:        Style{Reverse},
+		BoxStyle:                Style{},
+		BoxTextStyle:            Style{},
+		BarLabelStyle:           Style{Bold},
+		BarStyle:                Style{},
+		TimerStyle:              Style{},
+		TimestampStyle:          Style{},
+		Checkmark: Checkmark{
+			Checked:   "✓",
+			Unchecked: "✗",
+		},
+	}
 )
 
 // Theme for PTerm.
@@ -78,14 +194,18 @@ type Theme struct {
 	SpinnerStyle            Style
 	SpinnerTextStyle        Style
 	TimerStyle              Style
+	TimestampStyle          Style
 	TableStyle              Style
 	TableHeaderStyle        Style
+	TableFooterStyle        Style
 	TableSeparatorStyle     Style
+	TableRowSpanStyle       Style
 	SectionStyle            Style
 	BulletListTextStyle     Style
 	BulletListBulletStyle   Style
 	TreeStyle               Style
 	TreeTextStyle           Style
+	TreeAnnotationStyle     Style
 	LetterStyle             Style
 	DebugMessageStyle       Style
 	DebugPrefixStyle        Style
@@ -96,6 +216,121 @@ type Theme struct {
 	Checkmark               Checkmark
 }
 
+// LoadTheme reads a theme in JSON format from r.
+// Every Style is encoded as a list of color names, for example ["FgRed", "Bold"].
+// Styles, which are not contained in the JSON data, keep their value of ThemeDefault.
+// A theme can be exported with json.Marshal.
+//
+// Example:
+//
+//	f, _ := os.Open("mytheme.json")
+//	theme, err := pterm.LoadTheme(f)
+//	if err == nil {
+//		pterm.ThemeDefault = theme
+//	}
+func LoadTheme(r io.Reader) (Theme, error) {
+	// The styles are copied, so that decoding into them does not modify ThemeDefault.
+	theme := ThemeDefault.copy()
+	if err := json.NewDecoder(r).Decode(&theme); err != nil {
+		return Theme{}, err
+	}
+	return theme, nil
+}
+
+// themeLock guards the styles of ThemeDefault, while SetTheme or WithTheme replaces them.
+// The printers hold it for reading, while they render with the styles.
+var themeLock sync.RWMutex
+
+// SetTheme sets the theme, which is used by the default printers.
+// The default printers reference the styles of ThemeDefault, so the theme is used by all printers, which don't have custom styles.
+// Save ThemeDefault before calling SetTheme, to restore it later.
+//
+// Example:
+//
+//	pterm.SetTheme(pterm.ThemeMonochrome)
+func SetTheme(theme Theme) {
+	themeLock.Lock()
+	defer themeLock.Unlock()
+	// The styles are copied, so that changing ThemeDefault does not modify the given theme.
+	ThemeDefault = theme.copy()
+}
+
+// themeOverride is a theme, which is active while WithTheme is running.
+// previous is the theme, which is restored, when it ends.
+type themeOverride struct {
+	previous Theme
+}
+
+// themeOverrides contains the running WithTheme calls in the order they were started.
+var themeOverrides struct {
+	sync.Mutex
+	active []*themeOverride
+}
+
+// WithTheme sets the theme, which is used by the default printers, while fn is running.
+// Afterwards, the previous theme is restored, even if fn panics.
+// Calls can be nested. If they run concurrently, the theme of the call, which started last, is active
+// and the theme, which was active before all of them, is restored when the last one returns.
+//
+// Example:
+//
+//	pterm.WithTheme(pterm.ThemeMonochrome, func() {
+//		pterm.Info.Println("Printed with the monochrome theme")
+//	})
+func WithTheme(theme Theme, fn func()) {
+	override := pushTheme(theme)
+	defer popTheme(override)
+	fn()
+}
+
+// pushTheme activates the theme and remembers the theme, which was active before.
+func pushTheme(theme Theme) *themeOverride {
+	themeOverrides.Lock()
+	defer themeOverrides.Unlock()
+	themeLock.Lock()
+	defer themeLock.Unlock()
+
+	override := &themeOverride{previous: ThemeDefault}
+	themeOverrides.active = append(themeOverrides.active, override)
+	ThemeDefault = theme.copy()
+	return override
+}
+
+// popTheme ends the override.
+// If it is the latest one, its previous theme is restored.
+// Otherwise, the override started after it restores that theme instead, when it ends.
+func popTheme(override *themeOverride) {
+	themeOverrides.Lock()
+	defer themeOverrides.Unlock()
+
+	active := themeOverrides.active
+	for i, o := range active {
+		if o != override {
+			continue
+		}
+		if i == len(active)-1 {
+			themeLock.Lock()
+			ThemeDefault = override.previous
+			themeLock.Unlock()
+		} else {
+			active[i+1].previous = override.previous
+		}
+		themeOverrides.active = append(active[:i], active[i+1:]...)
+		return
+	}
+}
+
+// copy returns a copy of the theme, which does not share its styles with t.
+func (t Theme) copy() Theme {
+	v := reflect.ValueOf(&t).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if style, ok := v.Field(i).Interface().(Style); ok {
+			v.Field(i).Set(reflect.ValueOf(append(Style{}, style...)))
+		}
+	}
+	return t
+}
+
 // WithPrimaryStyle returns a new theme with overridden value.
 func (t Theme) WithPrimaryStyle(style Style) Theme {
 	t.PrimaryStyle = style
@@ -228,6 +463,12 @@ func (t Theme) WithTreeTextStyle(style Style) Theme {
 	return t
 }
 
+// WithTreeAnnotationStyle returns a new theme with overridden value.
+func (t Theme) WithTreeAnnotationStyle(style Style) Theme {
+	t.TreeAnnotationStyle = style
+	return t
+}
+
 // WithBoxStyle returns a new theme with overridden value.
 func (t Theme) WithBoxStyle(style Style) Theme {
 	t.BoxStyle = style
@@ -251,3 +492,9 @@ func (t Theme) WithBarStyle(style Style) Theme {
 	t.BarStyle = style
 	return t
 }
+
+// WithTimestampStyle returns a new theme with overridden value.
+func (t Theme) WithTimestampStyle(style Style) Theme {
+	t.TimestampStyle = style
+	return t
+}
@@ -1,7 +1,15 @@
 package pterm_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/MarvinJWendt/testza"
 	"github.com/pterm/pterm"
@@ -183,6 +191,14 @@ func TestTheme_WithTreeTextStyle(t *testing.T) {
 	testza.AssertEqual(t, s, p2.TreeTextStyle)
 }
 
+func TestTheme_WithTreeAnnotationStyle(t *testing.T) {
+	s := pterm.Style{pterm.FgRed, pterm.BgBlue, pterm.Bold}
+	p := pterm.Theme{}
+	p2 := p.WithTreeAnnotationStyle(s)
+
+	testza.AssertEqual(t, s, p2.TreeAnnotationStyle)
+}
+
 func TestTheme_WithBoxStyle(t *testing.T) {
 	s := pterm.Style{pterm.FgRed, pterm.BgBlue, pterm.Bold}
 	p := pterm.Theme{}
@@ -214,3 +230,190 @@ func TestTheme_WithBarStyle(t *testing.T) {
 
 	testza.AssertEqual(t, s, p2.BarStyle)
 }
+
+func TestTheme_WithTimestampStyle(t *testing.T) {
+	s := pterm.Style{pterm.FgRed, pterm.BgBlue, pterm.Bold}
+	p := pterm.Theme{}
+	p2 := p.WithTimestampStyle(s)
+
+	testza.AssertEqual(t, s, p2.TimestampStyle)
+}
+
+func TestTheme_JSONRoundTrip(t *testing.T) {
+	theme := pterm.ThemeDefault.WithPrimaryStyle(pterm.Style{pterm.FgGray, pterm.BgLightBlue, pterm.Bold, pterm.Color(38)})
+	data, err := json.Marshal(theme)
+	testza.AssertNoError(t, err)
+	testza.AssertContains(t, string(data), `"PrimaryStyle":["FgDarkGray","BgLightBlue","Bold","38"]`)
+
+	loaded, err := pterm.LoadTheme(bytes.NewReader(data))
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, theme, loaded)
+}
+
+func TestLoadTheme_KeepsDefaultStyles(t *testing.T) {
+	defaultInfoStyle := append(pterm.Style{}, pterm.ThemeDefault.InfoMessageStyle...)
+	loaded, err := pterm.LoadTheme(strings.NewReader(`{"SuccessMessageStyle": ["FgGray", "Italic"], "InfoMessageStyle": ["FgRed"]}`))
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, pterm.Style{pterm.FgGray, pterm.Italic}, loaded.SuccessMessageStyle)
+	testza.AssertEqual(t, pterm.Style{pterm.FgRed}, loaded.InfoMessageStyle)
+	testza.AssertEqual(t, pterm.ThemeDefault.WarningMessageStyle, loaded.WarningMessageStyle)
+	testza.AssertEqual(t, defaultInfoStyle, pterm.ThemeDefault.InfoMessageStyle)
+}
+
+func TestLoadTheme_UnknownColor(t *testing.T) {
+	_, err := pterm.LoadTheme(strings.NewReader(`{"PrimaryStyle": ["FgPurple"]}`))
+	testza.AssertTrue(t, errors.Is(err, pterm.ErrUnknownColor))
+	testza.AssertContains(t, err.Error(), "FgPurple")
+}
+
+func TestThemeMonochrome_OnlyUsesAttributes(t *testing.T) {
+	v := reflect.ValueOf(pterm.ThemeMonochrome)
+	for i := 0; i < v.NumField(); i++ {
+		style, ok := v.Field(i).Interface().(pterm.Style)
+		if !ok {
+			continue
+		}
+		for _, c := range style {
+			testza.AssertTrue(t, c == pterm.Bold || c == pterm.Underscore || c == pterm.Reverse, v.Type().Field(i).Name)
+		}
+	}
+	testza.AssertEqual(t, "✓", pterm.ThemeMonochrome.Checkmark.Checked)
+}
+
+func TestThemeHighContrast_SetsAllStyles(t *testing.T) {
+	v := reflect.ValueOf(pterm.ThemeHighContrast)
+	for i := 0; i < v.NumField(); i++ {
+		if style, ok := v.Field(i).Interface().(pterm.Style); ok {
+			testza.AssertNotZero(t, len(style), v.Type().Field(i).Name)
+		}
+	}
+}
+
+func TestSetTheme(t *testing.T) {
+	defaultTheme := pterm.ThemeDefault
+	defer pterm.SetTheme(defaultTheme)
+
+	pterm.SetTheme(pterm.ThemeMonochrome)
+	testza.AssertEqual(t, pterm.ThemeMonochrome, pterm.ThemeDefault)
+	testza.AssertEqual(t, pterm.Style{pterm.Bold, pterm.Reverse}, *pterm.Error.Prefix.Style)
+	testza.AssertEqual(t, "\x1b[1;4m\x1b[1;4mHello\x1b[0m\x1b[0m", pterm.DefaultSection.Style.Sprint("Hello"))
+
+	// changing the active theme does not modify the preset
+	pterm.ThemeDefault.PrimaryStyle[0] = pterm.Italic
+	testza.AssertEqual(t, pterm.Style{pterm.Bold}, pterm.ThemeMonochrome.PrimaryStyle)
+}
+
+func TestSetTheme_WhileLivePrintersRender(t *testing.T) {
+	defaultTheme := pterm.ThemeDefault
+	defer pterm.SetTheme(defaultTheme)
+
+	spinner, _ := pterm.DefaultSpinner.WithDelay(time.Millisecond).WithWriter(io.Discard).Start("Loading")
+	bar, _ := pterm.DefaultProgressbar.WithTotal(100).WithRefreshInterval(time.Millisecond).WithWriter(io.Discard).Start()
+	for i := 0; i < 20; i++ {
+		pterm.SetTheme(pterm.ThemeMonochrome)
+		pterm.SetTheme(defaultTheme)
+		bar.Increment()
+		time.Sleep(time.Millisecond)
+	}
+	bar.Stop()
+	spinner.Success()
+}
+
+func TestWithTheme(t *testing.T) {
+	defaultTheme := pterm.ThemeDefault
+	var inside pterm.Style
+	pterm.WithTheme(pterm.ThemeMonochrome, func() {
+		inside = *pterm.Error.Prefix.Style
+	})
+	testza.AssertEqual(t, pterm.Style{pterm.Bold, pterm.Reverse}, inside)
+	testza.AssertEqual(t, defaultTheme, pterm.ThemeDefault)
+}
+
+func TestWithTheme_Nested(t *testing.T) {
+	defaultTheme := pterm.ThemeDefault
+	red := pterm.ThemeDefault.WithPrimaryStyle(pterm.Style{pterm.FgRed})
+	pterm.WithTheme(pterm.ThemeMonochrome, func() {
+		pterm.WithTheme(red, func() {
+			testza.AssertEqual(t, red, pterm.ThemeDefault)
+		})
+		testza.AssertEqual(t, pterm.ThemeMonochrome, pterm.ThemeDefault)
+	})
+	testza.AssertEqual(t, defaultTheme, pterm.ThemeDefault)
+}
+
+func TestWithTheme_RestoresOnPanic(t *testing.T) {
+	defaultTheme := pterm.ThemeDefault
+	func() {
+		defer func() {
+			testza.AssertEqual(t, "failed", recover())
+		}()
+		pterm.WithTheme(pterm.ThemeMonochrome, func() {
+			panic("failed")
+		})
+	}()
+	testza.AssertEqual(t, defaultTheme, pterm.ThemeDefault)
+}
+
+func TestWithTheme_Concurrent(t *testing.T) {
+	defaultTheme := pterm.ThemeDefault
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pterm.WithTheme(pterm.ThemeMonochrome, func() {
+				time.Sleep(time.Millisecond)
+			})
+		}()
+	}
+	wg.Wait()
+	testza.AssertEqual(t, defaultTheme, pterm.ThemeDefault)
+}
+
+func TestWithTheme_WhileSpinnerRenders(t *testing.T) {
+	spinner, _ := pterm.DefaultSpinner.WithDelay(time.Millisecond).WithWriter(io.Discard).Start("Loading")
+	for i := 0; i < 20; i++ {
+		pterm.WithTheme(pterm.ThemeMonochrome, func() {
+			time.Sleep(time.Millisecond)
+		})
+	}
+	spinner.Success()
+}
+
+func TestWithTheme_WhilePrintersPrint(t *testing.T) {
+	defaultTheme := pterm.ThemeDefault
+	defer pterm.SetTheme(defaultTheme)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				pterm.Info.WithWriter(io.Discard).Println("Hello, World!")
+				pterm.DefaultLogger.WithWriter(io.Discard).Info("Hello, World!", "key", "value")
+				pterm.DefaultHeader.WithWriter(io.Discard).Println("Hello, World!")
+				pterm.DefaultSection.WithWriter(io.Discard).Println("Hello, World!")
+				pterm.DefaultBox.WithWriter(io.Discard).Println("Hello, World!")
+				pterm.DefaultTable.WithBoxed().WithData(pterm.TableData{{"a", "b"}}).WithWriter(io.Discard).Render()
+				pterm.DefaultTree.WithRoot(pterm.TreeNode{Children: []pterm.TreeNode{{Text: "a"}}}).WithWriter(io.Discard).Render()
+				pterm.DefaultBulletList.WithItems([]pterm.BulletListItem{{Text: "a"}}).WithWriter(io.Discard).Render()
+				pterm.DefaultBarChart.WithBars(pterm.Bars{{Label: "a", Value: 1}}).WithWriter(io.Discard).Render()
+			}
+		}
+	}()
+
+	for i := 0; i < 20; i++ {
+		pterm.WithTheme(pterm.ThemeMonochrome, func() {
+			time.Sleep(time.Millisecond)
+		})
+		pterm.SetTheme(pterm.ThemeMonochrome)
+		pterm.SetTheme(defaultTheme)
+	}
+	close(done)
+	wg.Wait()
+}
@@ -3,12 +3,18 @@ package pterm
 import (
 	"io"
 	"strings"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/pterm/pterm/internal"
 )
 
 // TreeNode is used as items in a TreePrinter.
 type TreeNode struct {
 	Children []TreeNode
 	Text     string
+	// Annotation is printed aligned on the right side of the Text, like the size of a file.
+	Annotation string
 }
 
 // LeveledList is a list, which contains multiple LeveledListItem.
@@ -25,6 +31,7 @@ type LeveledListItem struct {
 var DefaultTree = TreePrinter{
 	TreeStyle:            &ThemeDefault.TreeStyle,
 	TextStyle:            &ThemeDefault.TreeTextStyle,
+	AnnotationStyle:      &ThemeDefault.TreeAnnotationStyle,
 	TopRightCornerString: "└",
 	HorizontalString:     "─",
 	TopRightDownString:   "├",
@@ -45,9 +52,14 @@ type TreePrinter struct {
 	RightDownLeftString  string
 	Indent               int
 	Writer               io.Writer
+	AnnotationStyle      *Style
+	// AnnotationColumn is the column, at which the annotations of the nodes start.
+	// If it is zero, the annotations are aligned to the right edge of the terminal.
+	AnnotationColumn int
 }
 
 // WithTreeStyle returns a new list with a specific tree style.
+// The tree style is used for the connectors between the items.
 func (p TreePrinter) WithTreeStyle(style *Style) *TreePrinter {
 	p.TreeStyle = style
 	return &p
@@ -59,6 +71,22 @@ func (p TreePrinter) WithTextStyle(style *Style) *TreePrinter {
 	return &p
 }
 
+// WithAnnotationStyle returns a new list with a specific annotation style.
+func (p TreePrinter) WithAnnotationStyle(style *Style) *TreePrinter {
+	p.AnnotationStyle = style
+	return &p
+}
+
+// WithAnnotationColumn returns a new list, where the annotations of the nodes start at a specific column.
+// If column is zero, or below, the annotations are aligned to the right edge of the terminal.
+func (p TreePrinter) WithAnnotationColumn(column int) *TreePrinter {
+	if column < 0 {
+		column = 0
+	}
+	p.AnnotationColumn = column
+	return &p
+}
+
 // WithTopRightCornerString returns a new list with a specific TopRightCornerString.
 func (p TreePrinter) WithTopRightCornerString(s string) *TreePrinter {
 	p.TopRightCornerString = s
@@ -83,6 +111,17 @@ func (p TreePrinter) WithVerticalString(s string) *TreePrinter {
 	return &p
 }
 
+// WithASCIIConnectors returns a new list, which connects the items with ASCII characters instead of box-drawing characters.
+// This is useful for terminals and editors without Unicode support.
+func (p TreePrinter) WithASCIIConnectors() *TreePrinter {
+	p.TopRightCornerString = "`"
+	p.TopRightDownString = "+"
+	p.HorizontalString = "-"
+	p.VerticalString = "|"
+	p.RightDownLeftString = "+"
+	return &p
+}
+
 // WithRoot returns a new list with a specific Root.
 func (p TreePrinter) WithRoot(root TreeNode) *TreePrinter {
 	p.Root = root
@@ -121,10 +160,17 @@ func (p TreePrinter) Srender() (string, error) {
 	if p.TextStyle == nil {
 		p.TextStyle = NewStyle()
 	}
+	if p.AnnotationStyle == nil {
+		p.AnnotationStyle = NewStyle()
+	}
+
+	themeLock.RLock()
+	defer themeLock.RUnlock()
 
 	var result string
 	if p.Root.Text != "" {
-		result += p.Root.Text + "\n"
+		// the root is not styled
+		result += p.annotate("", p.Root.Text, NewStyle(), p.Root.Annotation) + "\n"
 	}
 	result += walkOverTree(p.Root.Children, p, "")
 	return result, nil
@@ -136,25 +182,61 @@ func (p TreePrinter) Srender() (string, error) {
 func walkOverTree(list []TreeNode, p TreePrinter, prefix string) string {
 	var ret string
 	for i, item := range list {
-		if len(list) > i+1 { // if not last in list
-			if len(item.Children) == 0 { // if there are no children
-				ret += prefix + p.TreeStyle.Sprint(p.TopRightDownString) + strings.Repeat(p.TreeStyle.Sprint(p.HorizontalString), p.Indent) +
-					p.TextStyle.Sprint(item.Text) + "\n"
-			} else { // if there are children
-				ret += prefix + p.TreeStyle.Sprint(p.TopRightDownString) + strings.Repeat(p.TreeStyle.Sprint(p.HorizontalString), p.Indent-1) +
-					p.TreeStyle.Sprint(p.RightDownLeftString) + p.TextStyle.Sprint(item.Text) + "\n"
-				ret += walkOverTree(item.Children, p, prefix+p.TreeStyle.Sprint(p.VerticalString)+strings.Repeat(" ", p.Indent-1))
-			}
-		} else if len(list) == i+1 { // if last in list
-			if len(item.Children) == 0 { // if there are no children
-				ret += prefix + p.TreeStyle.Sprint(p.TopRightCornerString) + strings.Repeat(p.TreeStyle.Sprint(p.HorizontalString), p.Indent) +
-					p.TextStyle.Sprint(item.Text) + "\n"
-			} else { // if there are children
-				ret += prefix + p.TreeStyle.Sprint(p.TopRightCornerString) + strings.Repeat(p.TreeStyle.Sprint(p.HorizontalString), p.Indent-1) +
-					p.TreeStyle.Sprint(p.RightDownLeftString) + p.TextStyle.Sprint(item.Text) + "\n"
-				ret += walkOverTree(item.Children, p, prefix+strings.Repeat(" ", p.Indent))
-			}
+		last := len(list) == i+1
+		connector := p.TopRightDownString
+		if last {
+			connector = p.TopRightCornerString
 		}
+
+		connectors := prefix + p.TreeStyle.Sprint(connector)
+		// the guide continues the lines of a multi-line text down to the children
+		guide := " "
+		if len(item.Children) == 0 { // if there are no children
+			connectors += strings.Repeat(p.TreeStyle.Sprint(p.HorizontalString), p.Indent)
+		} else { // if there are children
+			connectors += strings.Repeat(p.TreeStyle.Sprint(p.HorizontalString), p.Indent-1) + p.TreeStyle.Sprint(p.RightDownLeftString)
+			guide = p.TreeStyle.Sprint(p.VerticalString)
+		}
+
+		lines := strings.Split(item.Text, "\n")
+		ret += p.annotate(connectors, lines[0], p.TextStyle, item.Annotation) + "\n"
+		if len(lines) == 1 && len(item.Children) == 0 {
+			continue
+		}
+
+		// the children continue the vertical guide of this item, if more siblings follow
+		childPrefix := prefix + p.TreeStyle.Sprint(p.VerticalString) + strings.Repeat(" ", p.Indent-1)
+		if last {
+			childPrefix = prefix + strings.Repeat(" ", p.Indent)
+		}
+		for _, line := range lines[1:] {
+			ret += childPrefix + guide + p.TextStyle.Sprint(line) + "\n"
+		}
+		ret += walkOverTree(item.Children, p, childPrefix)
 	}
 	return ret
 }
+
+// annotate returns a line of the tree, which consists of the connectors, the text and the annotation.
+// The text is truncated, if the annotation would not fit into the line otherwise.
+func (p TreePrinter) annotate(connectors, text string, textStyle *Style, annotation string) string {
+	if annotation == "" {
+		return connectors + textStyle.Sprint(text)
+	}
+
+	lineWidth := p.AnnotationColumn
+	annotationWidth := runewidth.StringWidth(RemoveColorFromString(annotation))
+	if lineWidth <= 0 {
+		lineWidth = GetTerminalWidth() - annotationWidth
+	}
+
+	// at least one space is kept between the text and the annotation
+	connectorsWidth := runewidth.StringWidth(RemoveColorFromString(connectors))
+	text = internal.TruncateString(text, lineWidth-connectorsWidth-1, "…")
+	padding := lineWidth - connectorsWidth - runewidth.StringWidth(RemoveColorFromString(text))
+	if padding < 1 {
+		padding = 1
+	}
+
+	return connectors + textStyle.Sprint(text) + strings.Repeat(" ", padding) + p.AnnotationStyle.Sprint(annotation)
+}
@@ -2,9 +2,11 @@ package pterm_test
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/MarvinJWendt/testza"
+	"github.com/mattn/go-runewidth"
 	"github.com/pterm/pterm"
 )
 
@@ -206,3 +208,115 @@ func TestTreePrinter_WithWriter(t *testing.T) {
 	testza.AssertEqual(t, s, p2.Writer)
 	testza.AssertZero(t, p.Writer)
 }
+
+func TestTreePrinter_WithASCIIConnectors(t *testing.T) {
+	p := pterm.DefaultTree
+	p2 := p.WithASCIIConnectors()
+
+	testza.AssertEqual(t, "`", p2.TopRightCornerString)
+	testza.AssertEqual(t, "+", p2.TopRightDownString)
+	testza.AssertEqual(t, "-", p2.HorizontalString)
+	testza.AssertEqual(t, "|", p2.VerticalString)
+	testza.AssertEqual(t, "+", p2.RightDownLeftString)
+	testza.AssertEqual(t, "└", p.TopRightCornerString)
+}
+
+func TestTreePrinter_SrenderASCIIConnectors(t *testing.T) {
+	root := pterm.TreeNode{
+		Text: "root",
+		Children: []pterm.TreeNode{
+			{Text: "a", Children: []pterm.TreeNode{{Text: "a1"}, {Text: "a2"}}},
+			{Text: "b", Children: []pterm.TreeNode{{Text: "b1"}}},
+		},
+	}
+	content, err := pterm.DefaultTree.WithASCIIConnectors().WithRoot(root).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "root\n+-+a\n| +--a1\n| `--a2\n`-+b\n  `--b1\n", pterm.RemoveColorFromString(content))
+}
+
+func TestTreePrinter_WithAnnotationStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultTree.WithAnnotationStyle(s)
+
+	testza.AssertEqual(t, s, p.AnnotationStyle)
+}
+
+func TestTreePrinter_WithAnnotationColumn(t *testing.T) {
+	p := pterm.DefaultTree.WithAnnotationColumn(20)
+	testza.AssertEqual(t, 20, p.AnnotationColumn)
+	testza.AssertZero(t, pterm.DefaultTree.WithAnnotationColumn(-1).AnnotationColumn)
+}
+
+var annotatedTree = pterm.TreeNode{
+	Text:       "project",
+	Annotation: "12 KB",
+	Children: []pterm.TreeNode{
+		{Text: "main.go", Annotation: "2 KB"},
+		{Text: "internal", Children: []pterm.TreeNode{{Text: "util.go", Annotation: "10 KB"}}},
+	},
+}
+
+func TestTreePrinter_SrenderAnnotationColumn(t *testing.T) {
+	content, err := pterm.DefaultTree.WithASCIIConnectors().WithAnnotationColumn(16).WithRoot(annotatedTree).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, ""+
+		"project         12 KB\n"+
+		"+--main.go      2 KB\n"+
+		"`-+internal\n"+
+		"  `--util.go    10 KB\n", pterm.RemoveColorFromString(content))
+}
+
+func TestTreePrinter_SrenderAnnotationRightEdge(t *testing.T) {
+	content, err := pterm.DefaultTree.WithRoot(annotatedTree).Srender()
+	testza.AssertNoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(pterm.RemoveColorFromString(content), "\n"), "\n")
+	for _, i := range []int{0, 1, 3} {
+		testza.AssertEqual(t, pterm.GetTerminalWidth(), runewidth.StringWidth(lines[i]), lines[i])
+	}
+	testza.AssertEqual(t, "└─┬internal", lines[2])
+	testza.AssertTrue(t, strings.HasSuffix(lines[3], " 10 KB"))
+}
+
+func TestTreePrinter_SrenderAnnotationTruncatesText(t *testing.T) {
+	root := pterm.TreeNode{Children: []pterm.TreeNode{{Text: "a very long file name.go", Annotation: "1 KB"}}}
+	content, err := pterm.DefaultTree.WithASCIIConnectors().WithAnnotationColumn(12).WithRoot(root).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "`--a very … 1 KB\n", pterm.RemoveColorFromString(content))
+}
+
+func TestTreePrinter_SrenderGuidesOfAncestorsWithLaterSiblings(t *testing.T) {
+	root := pterm.TreeNode{Children: []pterm.TreeNode{
+		{Text: "a", Children: []pterm.TreeNode{
+			{Text: "a1", Children: []pterm.TreeNode{{Text: "a1x"}, {Text: "a1y"}}},
+			{Text: "a2"},
+		}},
+		{Text: "b", Children: []pterm.TreeNode{{Text: "b1", Children: []pterm.TreeNode{{Text: "b1x"}}}}},
+	}}
+	content, err := pterm.DefaultTree.WithRoot(root).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, ""+
+		"├─┬a\n"+
+		"│ ├─┬a1\n"+
+		"│ │ ├──a1x\n"+
+		"│ │ └──a1y\n"+
+		"│ └──a2\n"+
+		"└─┬b\n"+
+		"  └─┬b1\n"+
+		"    └──b1x\n", pterm.RemoveColorFromString(content))
+}
+
+func TestTreePrinter_SrenderMultiLineTextKeepsGuides(t *testing.T) {
+	root := pterm.TreeNode{Children: []pterm.TreeNode{
+		{Text: "a\nsecond line", Children: []pterm.TreeNode{{Text: "a1\nsecond line"}}},
+		{Text: "b"},
+	}}
+	content, err := pterm.DefaultTree.WithRoot(root).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, ""+
+		"├─┬a\n"+
+		"│ │second line\n"+
+		"│ └──a1\n"+
+		"│    second line\n"+
+		"└──b\n", pterm.RemoveColorFromString(content))
+}
@@ -18,12 +18,32 @@ var terminalHeight = 60
 
 func TestMain(m *testing.M) {
 	pterm.SetForcedTerminalSize(terminalWidth, terminalHeight)
+	// key presses are simulated, while stdin is not a terminal
+	pterm.SetForceRawInput(true)
 	setupStdoutCapture()
 	exitVal := m.Run()
 	teardownStdoutCapture()
 	os.Exit(exitVal)
 }
 
+// simulateLineInput replaces stdin with the input, so that interactive printers read lines from it until the test is done.
+func simulateLineInput(t *testing.T, input string) {
+	r, w, err := os.Pipe()
+	testza.AssertNoError(t, err)
+	_, err = w.WriteString(input)
+	testza.AssertNoError(t, err)
+	w.Close()
+
+	stdin := os.Stdin
+	os.Stdin = r
+	pterm.SetForceRawInput(false)
+	t.Cleanup(func() {
+		pterm.SetForceRawInput(true)
+		os.Stdin = stdin
+		r.Close()
+	})
+}
+
 // testPrintContains can be used to test Print methods.
 func testPrintContains(t *testing.T, logic func(w io.Writer, a interface{})) {
 	for _, printable := range printables {
@@ -224,11 +244,13 @@ var outBuf Buffer
 func setupStdoutCapture() {
 	outBuf.Reset()
 	pterm.SetDefaultOutput(&outBuf)
+	pterm.SetErrorWriter(&outBuf)
 }
 
 // teardownStdoutCapture restores the real stdout.
 func teardownStdoutCapture() {
 	pterm.SetDefaultOutput(os.Stdout)
+	pterm.SetErrorWriter(os.Stderr)
 }
 
 // captureStdout simulates capturing of os.stdout with a buffer and returns what was writted to the screen
@@ -246,5 +268,5 @@ func readStdout() string {
 }
 
 func proxyToDevNull() {
-	pterm.SetDefaultOutput(os.NewFile(0, os.DevNull))
+	pterm.SetDefaultOutput(io.Discard)
 }